@@ -20,6 +20,8 @@ import (
 	"github.com/urfave/cli/v3"
 	"go.opentelemetry.io/collector/processor/batchprocessor"
 	"go.opentelemetry.io/collector/processor/memorylimiterprocessor"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/clientcmd"
@@ -32,6 +34,7 @@ import (
 	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
 	configinstall "github.com/gardener/gardener-extension-otelcol/pkg/apis/config/install"
 	"github.com/gardener/gardener-extension-otelcol/pkg/controller"
+	"github.com/gardener/gardener-extension-otelcol/pkg/healthcheck"
 	"github.com/gardener/gardener-extension-otelcol/pkg/heartbeat"
 	"github.com/gardener/gardener-extension-otelcol/pkg/mgr"
 )
@@ -57,6 +60,7 @@ type flags struct {
 	zapLogLevel               string
 	zapLogFormat              string
 	resyncInterval            time.Duration
+	healthCheckSyncPeriod     time.Duration
 	pprofBindAddr             string
 	clientConnQPS             float32
 	clientConnBurst           int32
@@ -73,6 +77,12 @@ type flags struct {
 	batchProcessorBatchSize    uint32
 	batchProcessorBatchMaxSize uint32
 
+	// VerticalPodAutoscaler flags
+	vpaEnabled             bool
+	vpaMinAllowed          corev1.ResourceList
+	vpaMaxAllowed          corev1.ResourceList
+	vpaControlledResources []string
+
 	// The following flags are meant to be specified by the Helm chart,
 	// which gardenlet will invoke during deployment. The value of each flag
 	// is derived from a list of extra values, which gardenlet passes to
@@ -148,6 +158,8 @@ func getFlags(ctx context.Context) *flags {
 func New() *cli.Command {
 	flags := flags{
 		gardenletFeatureGates: make(map[featuregate.Feature]bool),
+		vpaMinAllowed:         make(corev1.ResourceList),
+		vpaMaxAllowed:         make(corev1.ResourceList),
 	}
 
 	cmd := &cli.Command{
@@ -280,6 +292,13 @@ func New() *cli.Command {
 				Sources:     cli.EnvVars("RESYNC_INTERVAL"),
 				Destination: &flags.resyncInterval,
 			},
+			&cli.DurationFlag{
+				Name:        "health-check-sync-period",
+				Usage:       "interval on which the health of the extension resources is checked",
+				Value:       30 * time.Second,
+				Sources:     cli.EnvVars("HEALTH_CHECK_SYNC_PERIOD"),
+				Destination: &flags.healthCheckSyncPeriod,
+			},
 			&cli.Float32Flag{
 				Name:        "client-conn-qps",
 				Usage:       "allowed client queries per second for the connection",
@@ -374,6 +393,36 @@ func New() *cli.Command {
 				Sources:     cli.EnvVars("BATCH_PROCESSOR_BATCH_MAX_SIZE"),
 				Destination: &flags.batchProcessorBatchMaxSize,
 			},
+			&cli.BoolFlag{
+				Name:        "vpa-enabled",
+				Usage:       "deploy vertical pod autoscalers for the collector and the target allocator",
+				Value:       true,
+				Sources:     cli.EnvVars("VPA_ENABLED"),
+				Destination: &flags.vpaEnabled,
+			},
+			&cli.StringMapFlag{
+				Name:    "vpa-min-allowed",
+				Usage:   "lower bound of the recommended requests of a resource, e.g. memory=50Mi",
+				Sources: cli.EnvVars("VPA_MIN_ALLOWED"),
+				Action: func(ctx context.Context, c *cli.Command, items map[string]string) error {
+					return parseResourceList(items, flags.vpaMinAllowed)
+				},
+			},
+			&cli.StringMapFlag{
+				Name:    "vpa-max-allowed",
+				Usage:   "upper bound of the recommended requests of a resource, e.g. memory=4Gi",
+				Sources: cli.EnvVars("VPA_MAX_ALLOWED"),
+				Action: func(ctx context.Context, c *cli.Command, items map[string]string) error {
+					return parseResourceList(items, flags.vpaMaxAllowed)
+				},
+			},
+			&cli.StringSliceFlag{
+				Name:        "vpa-controlled-resources",
+				Usage:       "resources controlled by the vertical pod autoscalers, cpu and/or memory",
+				Value:       []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)},
+				Sources:     cli.EnvVars("VPA_CONTROLLED_RESOURCES"),
+				Destination: &flags.vpaControlledResources,
+			},
 		},
 		Before: func(ctx context.Context, c *cli.Command) (context.Context, error) {
 			ctrllog.SetLogger(glogger.MustNewZapLogger(flags.zapLogLevel, flags.zapLogFormat))
@@ -387,6 +436,20 @@ func New() *cli.Command {
 	return cmd
 }
 
+// parseResourceList parses the given resource quantities by resource name
+// into the given [corev1.ResourceList].
+func parseResourceList(items map[string]string, resources corev1.ResourceList) error {
+	for name, val := range items {
+		quantity, err := resource.ParseQuantity(val)
+		if err != nil {
+			return fmt.Errorf("invalid quantity for resource %s: %w", name, err)
+		}
+		resources[corev1.ResourceName(name)] = quantity
+	}
+
+	return nil
+}
+
 // runManager starts the controller manager
 func runManager(ctx context.Context, cmd *cli.Command) error {
 	logger := ctrllog.Log.WithName("manager-setup")
@@ -413,6 +476,15 @@ func runManager(ctx context.Context, cmd *cli.Command) error {
 		SendBatchMaxSize: flags.batchProcessorBatchMaxSize,
 	}
 
+	vpaConfig := &actuator.VPAConfig{
+		Enabled:    flags.vpaEnabled,
+		MinAllowed: flags.vpaMinAllowed,
+		MaxAllowed: flags.vpaMaxAllowed,
+	}
+	for _, name := range flags.vpaControlledResources {
+		vpaConfig.ControlledResources = append(vpaConfig.ControlledResources, corev1.ResourceName(name))
+	}
+
 	decoder := serializer.NewCodecFactory(m.GetScheme(), serializer.EnableStrict).UniversalDecoder()
 	act, err := actuator.New(
 		m.GetClient(),
@@ -421,6 +493,7 @@ func runManager(ctx context.Context, cmd *cli.Command) error {
 		actuator.WithGardenletFeatures(flags.gardenletFeatureGates),
 		actuator.WithMemoryLimiterProcessorConfig(memLimiterConfig),
 		actuator.WithBatchProcessorConfig(batchProcessorConfig),
+		actuator.WithVPAConfig(vpaConfig),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create actuator: %w", err)
@@ -446,6 +519,20 @@ func runManager(ctx context.Context, cmd *cli.Command) error {
 		return fmt.Errorf("failed to setup controller with manager: %w", err)
 	}
 
+	hc, err := healthcheck.New(
+		healthcheck.WithExtensionType(act.ExtensionType()),
+		healthcheck.WithExtensionClass(act.ExtensionClass()),
+		healthcheck.WithSyncPeriod(flags.healthCheckSyncPeriod),
+		healthcheck.WithMaxConcurrentReconciles(flags.maxConcurrentReconciles),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create health check controller: %w", err)
+	}
+
+	if err := hc.SetupWithManager(ctx, m); err != nil {
+		return fmt.Errorf("failed to setup health check controller with manager: %w", err)
+	}
+
 	if flags.gardenerVersion != "" {
 		logger.Info("configured gardener version", "version", flags.gardenerVersion)
 	}
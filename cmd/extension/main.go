@@ -12,6 +12,8 @@ import (
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	controllercmd "github.com/gardener/gardener-extension-otelcol/cmd/extension/controller"
+	rendercmd "github.com/gardener/gardener-extension-otelcol/cmd/extension/render"
+	validatecmd "github.com/gardener/gardener-extension-otelcol/cmd/extension/validate"
 	webhookcmd "github.com/gardener/gardener-extension-otelcol/cmd/extension/webhook"
 	"github.com/gardener/gardener-extension-otelcol/pkg/version"
 )
@@ -25,6 +27,8 @@ func main() {
 		Commands: []*cli.Command{
 			controllercmd.New(),
 			webhookcmd.New(),
+			rendercmd.New(),
+			validatecmd.New(),
 		},
 	}
 
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/urfave/cli/v3"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	configinstall "github.com/gardener/gardener-extension-otelcol/pkg/apis/config/install"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/validation"
+)
+
+// defaultNamespace is the namespace, into which the objects are rendered, if
+// no namespace is specified and none can be derived from the input.
+const defaultNamespace = "shoot--local--local"
+
+// flags stores the render flags as provided from the command-line
+type flags struct {
+	file      string
+	namespace string
+}
+
+// New creates a new [cli.Command] for rendering the objects, which the
+// extension deploys for a given provider configuration.
+func New() *cli.Command {
+	flags := flags{}
+
+	cmd := &cli.Command{
+		Name:    "render",
+		Aliases: []string{"r"},
+		Usage:   "render the objects deployed by the extension for a provider config",
+		Description: "Reads a Shoot manifest or a CollectorConfig from the given file and prints " +
+			"the objects, which the extension deploys into the seed and shoot clusters, as YAML. " +
+			"No cluster is needed, the generated secrets are stubbed.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "file",
+				Aliases:     []string{"f"},
+				Usage:       "path to a Shoot manifest or a CollectorConfig, or - to read from stdin",
+				Required:    true,
+				Destination: &flags.file,
+			},
+			&cli.StringFlag{
+				Name:        "namespace",
+				Aliases:     []string{"n"},
+				Usage:       "shoot namespace in the seed cluster, derived from the Shoot if not specified",
+				Destination: &flags.namespace,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			// No logger is set up for this command, so the error is
+			// printed to stderr instead.
+			if err := runRender(cmd.Writer, &flags); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// runRender renders the objects for the input specified by the given [flags]
+// and writes them to w.
+func runRender(w io.Writer, f *flags) error {
+	data, err := readInput(f.file)
+	if err != nil {
+		return err
+	}
+
+	scheme := runtime.NewScheme()
+	if err := gardencorev1beta1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	configinstall.Install(scheme)
+
+	cfg, cluster, err := decodeInput(scheme, data)
+	if err != nil {
+		return err
+	}
+
+	namespace := f.namespace
+	if namespace == "" {
+		namespace = getNamespace(cluster.Shoot)
+	}
+
+	act, err := actuator.New(
+		fakeclient.NewClientBuilder().WithScheme(scheme).Build(),
+		actuator.WithDecoder(serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder()),
+	)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := act.Render(namespace, cfg, cluster)
+	if err != nil {
+		return err
+	}
+
+	if err := writeObjects(w, kubernetes.SeedScheme, kubernetes.SeedSerializer, rendered.Seed); err != nil {
+		return err
+	}
+
+	return writeObjects(w, kubernetes.ShootScheme, kubernetes.ShootSerializer, rendered.Shoot)
+}
+
+// readInput reads the contents of the file at the given path, or from stdin
+// if the path is -.
+func readInput(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+
+	data, err := os.ReadFile(path) // #nosec: G304
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	return data, nil
+}
+
+// decodeInput decodes the given Shoot manifest or CollectorConfig and returns
+// the provider configuration of the extension together with the
+// [extensionscontroller.Cluster] it is rendered for.
+func decodeInput(scheme *runtime.Scheme, data []byte) (config.CollectorConfig, *extensionscontroller.Cluster, error) {
+	var cfg config.CollectorConfig
+
+	codecs := serializer.NewCodecFactory(scheme, serializer.EnableStrict)
+	obj, _, err := codecs.UniversalDeserializer().Decode(data, nil, nil)
+	if err != nil {
+		return cfg, nil, fmt.Errorf("failed to decode input: %w", err)
+	}
+
+	shoot := &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "local",
+			Namespace: "garden-local",
+		},
+	}
+
+	providerConfig := data
+	switch o := obj.(type) {
+	case *gardencorev1beta1.Shoot:
+		shoot = o
+		idx := slices.IndexFunc(shoot.Spec.Extensions, func(ext gardencorev1beta1.Extension) bool {
+			return ext.Type == actuator.ExtensionType
+		})
+		if idx == -1 {
+			return cfg, nil, fmt.Errorf("extension %s is not configured in shoot %s", actuator.ExtensionType, shoot.Name)
+		}
+
+		ext := shoot.Spec.Extensions[idx]
+		if ext.ProviderConfig == nil || len(ext.ProviderConfig.Raw) == 0 {
+			return cfg, nil, errors.New("no provider config specified")
+		}
+		providerConfig = ext.ProviderConfig.Raw
+	case *v1alpha1.CollectorConfig:
+	default:
+		return cfg, nil, fmt.Errorf("unsupported input of type %T", obj)
+	}
+
+	if err := runtime.DecodeInto(codecs.UniversalDecoder(), providerConfig, &cfg); err != nil {
+		return cfg, nil, fmt.Errorf("invalid provider spec configuration: %w", err)
+	}
+
+	if _, ok := obj.(*v1alpha1.CollectorConfig); ok {
+		shoot.Spec.Resources = getStubResources(cfg)
+	}
+
+	return cfg, &extensionscontroller.Cluster{Shoot: shoot}, nil
+}
+
+// getStubResources returns the shoot resources for a CollectorConfig, which is
+// rendered without a Shoot. Each referenced resource is resolved to a secret of
+// the same name, which results in the well-known name ref-<name> of the secret
+// in the seed.
+func getStubResources(cfg config.CollectorConfig) []gardencorev1beta1.NamedResourceReference {
+	resources := make([]gardencorev1beta1.NamedResourceReference, 0)
+	for _, r := range validation.ReferencedResources(cfg) {
+		name := r.Ref.ResourceRef.Name
+		if slices.ContainsFunc(resources, func(res gardencorev1beta1.NamedResourceReference) bool { return res.Name == name }) {
+			continue
+		}
+
+		resources = append(resources, gardencorev1beta1.NamedResourceReference{
+			Name: name,
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "Secret",
+				Name:       name,
+			},
+		})
+	}
+
+	return resources
+}
+
+// getNamespace returns the namespace of the given shoot in the seed cluster.
+func getNamespace(shoot *gardencorev1beta1.Shoot) string {
+	projectName, ok := strings.CutPrefix(shoot.Namespace, "garden-")
+	if !ok && shoot.Status.TechnicalID == "" {
+		return defaultNamespace
+	}
+
+	return gardenerutils.ComputeTechnicalID(projectName, shoot)
+}
+
+// writeObjects writes the given objects as YAML documents to w.
+func writeObjects(w io.Writer, scheme *runtime.Scheme, s *jsonserializer.Serializer, objects []client.Object) error {
+	for _, obj := range objects {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return err
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+		if _, err := io.WriteString(w, "---\n"); err != nil {
+			return err
+		}
+
+		if err := s.Encode(obj, w); err != nil {
+			return fmt.Errorf("failed to encode %s %s: %w", gvk.Kind, obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
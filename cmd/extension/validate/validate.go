@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencoreinstall "github.com/gardener/gardener/pkg/apis/core/install"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/urfave/cli/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+	"github.com/gardener/gardener-extension-otelcol/pkg/admission/validator"
+	configinstall "github.com/gardener/gardener-extension-otelcol/pkg/apis/config/install"
+)
+
+const (
+	// outputFormatText is the output format for human-readable results.
+	outputFormatText = "text"
+	// outputFormatJSON is the output format for machine-readable results.
+	outputFormatJSON = "json"
+)
+
+// flags stores the validate flags as provided from the command-line
+type flags struct {
+	outputFormat string
+	checkSecrets bool
+}
+
+// shootFile is a [core.Shoot] together with the file it was read from.
+type shootFile struct {
+	file  string
+	shoot *core.Shoot
+}
+
+// result is a single validation error of a provider config.
+type result struct {
+	// File is the file, which contains the shoot.
+	File string `json:"file"`
+	// Shoot is the namespace and name of the shoot, if the error refers to
+	// a shoot.
+	Shoot string `json:"shoot,omitempty"`
+	// Field is the path of the invalid field, if the error refers to a
+	// field.
+	Field string `json:"field,omitempty"`
+	// Type is the type of the error, if the error refers to a field.
+	Type string `json:"type,omitempty"`
+	// Detail describes the error.
+	Detail string `json:"detail"`
+}
+
+// String implements the [fmt.Stringer] interface.
+func (r result) String() string {
+	switch {
+	case r.Shoot == "":
+		return fmt.Sprintf("%s: %s", r.File, r.Detail)
+	case r.Field == "":
+		return fmt.Sprintf("%s: shoot %s: %s", r.File, r.Shoot, r.Detail)
+	}
+
+	return fmt.Sprintf("%s: shoot %s: %s: %s: %s", r.File, r.Shoot, r.Field, r.Type, r.Detail)
+}
+
+// New creates a new [cli.Command] for validating the provider configs of the
+// extension in Shoot manifests.
+func New() *cli.Command {
+	flags := flags{}
+
+	cmd := &cli.Command{
+		Name:      "validate",
+		Aliases:   []string{"v"},
+		Usage:     "validate the provider configs of the extension in shoot manifests",
+		ArgsUsage: "FILE...",
+		Description: "Decodes the provider config of each enabled otelcol extension in the given " +
+			"Shoot manifests with the strict decoder and validates it in the same way as the " +
+			"admission webhook. Exits with a non-zero exit code, if any provider config is invalid.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "output format, text or json",
+				Value:   outputFormatText,
+				Validator: func(val string) error {
+					if !slices.Contains([]string{outputFormatText, outputFormatJSON}, val) {
+						return errors.New("invalid output format specified")
+					}
+
+					return nil
+				},
+				Destination: &flags.outputFormat,
+			},
+			&cli.BoolFlag{
+				Name:        "check-secrets",
+				Usage:       "check the data keys of the referenced secrets, which must be included in the given files",
+				Destination: &flags.checkSecrets,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() == 0 {
+				return cli.Exit("no files specified", 1)
+			}
+
+			// No logger is set up for this command, so errors, which
+			// are not reported as validation results, are printed to
+			// stderr instead.
+			err := runValidate(ctx, cmd.Writer, &flags, cmd.Args().Slice())
+			var exitErr cli.ExitCoder
+			if err != nil && !errors.As(err, &exitErr) {
+				return cli.Exit(err, 1)
+			}
+
+			return err
+		},
+	}
+
+	return cmd
+}
+
+// runValidate validates the shoots in the given files and writes the results
+// to w.
+func runValidate(ctx context.Context, w io.Writer, f *flags, files []string) error {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return err
+	}
+	gardencoreinstall.Install(scheme)
+	configinstall.Install(scheme)
+
+	codecs := serializer.NewCodecFactory(scheme, serializer.EnableStrict)
+
+	shoots := make([]shootFile, 0)
+	secrets := make([]client.Object, 0)
+	results := make([]result, 0)
+	for _, file := range files {
+		objs, err := readObjects(codecs.UniversalDeserializer(), file)
+		if err != nil {
+			results = append(results, result{File: file, Detail: err.Error()})
+
+			continue
+		}
+
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *gardencorev1beta1.Shoot:
+				shoot := &core.Shoot{}
+				if err := scheme.Convert(o, shoot, nil); err != nil {
+					return fmt.Errorf("failed to convert shoot %s: %w", o.Name, err)
+				}
+				shoots = append(shoots, shootFile{file: file, shoot: shoot})
+			case *corev1.Secret:
+				secrets = append(secrets, o)
+			default:
+				results = append(results, result{
+					File:   file,
+					Detail: fmt.Sprintf("unsupported object of kind %s, expected a Shoot or a Secret", obj.GetObjectKind().GroupVersionKind().Kind),
+				})
+			}
+		}
+
+		if len(objs) == 0 {
+			results = append(results, result{File: file, Detail: "no shoot found"})
+		}
+	}
+
+	var reader client.Reader
+	if f.checkSecrets {
+		reader = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(secrets...).Build()
+	}
+
+	for _, sf := range shoots {
+		err := validator.ValidateShoot(ctx, codecs.UniversalDecoder(), reader, sf.shoot)
+		results = append(results, getResults(sf, err)...)
+	}
+
+	if err := writeResults(w, f.outputFormat, len(shoots), results); err != nil {
+		return err
+	}
+
+	if len(results) > 0 {
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
+
+// readObjects decodes all objects from the YAML documents in the given file.
+// Documents of kinds, which are not known, result in an error.
+func readObjects(decoder runtime.Decoder, file string) ([]runtime.Object, error) {
+	data, err := os.ReadFile(file) // #nosec: G304
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	objs := make([]runtime.Object, 0)
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document: %w", err)
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode document: %w", err)
+		}
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
+
+// getResults converts the error returned when validating the given shoot into
+// a list of [result] items.
+func getResults(sf shootFile, err error) []result {
+	if err == nil {
+		return nil
+	}
+
+	shootName := fmt.Sprintf("%s/%s", sf.shoot.Namespace, sf.shoot.Name)
+	idx := slices.IndexFunc(sf.shoot.Spec.Extensions, func(ext core.Extension) bool {
+		return ext.Type == actuator.ExtensionType
+	})
+	providerConfigPath := field.NewPath("spec", "extensions").Index(idx).Child("providerConfig")
+
+	var agg utilerrors.Aggregate
+	if !errors.As(err, &agg) {
+		return []result{{File: sf.file, Shoot: shootName, Detail: err.Error()}}
+	}
+
+	results := make([]result, 0, len(agg.Errors()))
+	for _, e := range agg.Errors() {
+		var fieldErr *field.Error
+		if !errors.As(e, &fieldErr) {
+			results = append(results, result{File: sf.file, Shoot: shootName, Detail: e.Error()})
+
+			continue
+		}
+
+		results = append(results, result{
+			File:   sf.file,
+			Shoot:  shootName,
+			Field:  providerConfigPath.String() + "." + fieldErr.Field,
+			Type:   fieldErr.Type.String(),
+			Detail: fieldErr.Detail,
+		})
+	}
+
+	return results
+}
+
+// writeResults writes the given results in the given output format to w.
+func writeResults(w io.Writer, outputFormat string, numShoots int, results []result) error {
+	if outputFormat == outputFormatJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(results)
+	}
+
+	for _, r := range results {
+		if _, err := fmt.Fprintln(w, r); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "validated %d shoot(s), found %d error(s)\n", numShoots, len(results))
+
+	return err
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validate_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/urfave/cli/v3"
+
+	"github.com/gardener/gardener-extension-otelcol/cmd/extension/validate"
+)
+
+const (
+	validShoot = `apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: local
+  namespace: garden-local
+spec:
+  extensions:
+    - type: otelcol
+      providerConfig:
+        apiVersion: otelcol.extensions.gardener.cloud/v1alpha1
+        kind: CollectorConfig
+        spec:
+          exporters:
+            debug:
+              enabled: true
+`
+
+	invalidShoot = `apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: invalid
+  namespace: garden-local
+spec:
+  extensions:
+    - type: other
+    - type: otelcol
+      providerConfig:
+        apiVersion: otelcol.extensions.gardener.cloud/v1alpha1
+        kind: CollectorConfig
+        spec:
+          exporters:
+            debug:
+              enabled: false
+`
+
+	collectorConfig = `apiVersion: otelcol.extensions.gardener.cloud/v1alpha1
+kind: CollectorConfig
+spec:
+  exporters:
+    debug:
+      enabled: true
+`
+)
+
+var _ = Describe("Validate", func() {
+	var (
+		ctx = context.TODO()
+		dir string
+		out *bytes.Buffer
+	)
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+
+		return path
+	}
+
+	run := func(args ...string) error {
+		cmd := validate.New()
+		cmd.Writer = out
+		cmd.ErrWriter = out
+		// Do not exit the test process on errors with an exit code
+		cmd.ExitErrHandler = func(context.Context, *cli.Command, error) {}
+
+		return cmd.Run(ctx, append([]string{"validate"}, args...))
+	}
+
+	expectExitCode := func(err error, code int) {
+		var exitErr cli.ExitCoder
+		Expect(errors.As(err, &exitErr)).To(BeTrue())
+		Expect(exitErr.ExitCode()).To(Equal(code))
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		out = &bytes.Buffer{}
+	})
+
+	It("should succeed for a valid shoot", func() {
+		Expect(run(writeFile("shoot.yaml", validShoot))).To(Succeed())
+		Expect(out.String()).To(Equal("validated 1 shoot(s), found 0 error(s)\n"))
+	})
+
+	It("should report the path of the invalid field in the provider config as text", func() {
+		file := writeFile("shoot.yaml", validShoot+"---\n"+invalidShoot)
+
+		expectExitCode(run(file), 1)
+		Expect(out.String()).To(Equal(
+			file + ": shoot garden-local/invalid: spec.extensions[1].providerConfig.spec.exporters: Required value: no exporter enabled\n" +
+				"validated 2 shoot(s), found 1 error(s)\n",
+		))
+	})
+
+	It("should report the errors as JSON", func() {
+		file := writeFile("shoot.yaml", invalidShoot)
+
+		expectExitCode(run("--output", "json", file), 1)
+
+		var results []map[string]string
+		Expect(json.Unmarshal(out.Bytes(), &results)).To(Succeed())
+		Expect(results).To(ConsistOf(map[string]string{
+			"file":   file,
+			"shoot":  "garden-local/invalid",
+			"field":  "spec.extensions[1].providerConfig.spec.exporters",
+			"type":   "Required value",
+			"detail": "no exporter enabled",
+		}))
+	})
+
+	It("should report an empty list as JSON for a valid shoot", func() {
+		Expect(run("-o", "json", writeFile("shoot.yaml", validShoot))).To(Succeed())
+		Expect(out.String()).To(Equal("[]\n"))
+	})
+
+	It("should report a file with a CollectorConfig instead of a shoot", func() {
+		file := writeFile("config.yaml", collectorConfig)
+
+		expectExitCode(run(file), 1)
+		Expect(out.String()).To(ContainSubstring(file + ": unsupported object of kind CollectorConfig, expected a Shoot or a Secret"))
+	})
+
+	It("should report a file with a mistyped kind", func() {
+		file := writeFile("shoot.yaml", "apiVersion: core.gardener.cloud/v1beta1\nkind: Shot\nmetadata:\n  name: local\n")
+
+		expectExitCode(run(file), 1)
+		Expect(out.String()).To(ContainSubstring(`no kind "Shot" is registered`))
+	})
+
+	It("should report a file without any objects", func() {
+		file := writeFile("empty.yaml", "")
+
+		expectExitCode(run(file), 1)
+		Expect(out.String()).To(ContainSubstring(file + ": no shoot found"))
+	})
+
+	It("should report a file, which does not exist", func() {
+		file := filepath.Join(dir, "missing.yaml")
+
+		expectExitCode(run(file), 1)
+		Expect(out.String()).To(ContainSubstring(file + ": failed to read file"))
+	})
+
+	It("should fail without files", func() {
+		err := run()
+		expectExitCode(err, 1)
+		Expect(err).To(MatchError("no files specified"))
+	})
+})
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	admissionmutator "github.com/gardener/gardener-extension-otelcol/pkg/admission/mutator"
 	admissionvalidator "github.com/gardener/gardener-extension-otelcol/pkg/admission/validator"
 	configinstall "github.com/gardener/gardener-extension-otelcol/pkg/apis/config/install"
 	"github.com/gardener/gardener-extension-otelcol/pkg/mgr"
@@ -467,6 +468,7 @@ func runWebhookServer(ctx context.Context, cmd *cli.Command) error {
 	webhooks := make([]*extensionswebhook.Webhook, 0)
 	webhookFuncs := []func(m ctrl.Manager) (*extensionswebhook.Webhook, error){
 		admissionvalidator.NewShootValidatorWebhook,
+		admissionmutator.NewShootMutatorWebhook,
 	}
 
 	for _, webhookFunc := range webhookFuncs {
@@ -7,11 +7,16 @@
 package actuator
 
 import (
+	"cmp"
 	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"maps"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -19,7 +24,6 @@ import (
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/extension"
-	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -33,18 +37,20 @@ import (
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
-	otelv1alpha1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1alpha1"
 	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
 	"github.com/go-logr/logr"
 	"go.opentelemetry.io/collector/processor/batchprocessor"
 	"go.opentelemetry.io/collector/processor/memorylimiterprocessor"
 	"go.yaml.in/yaml/v4"
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/component-base/featuregate"
@@ -53,8 +59,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/v1alpha1"
 	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/validation"
-	"github.com/gardener/gardener-extension-otelcol/pkg/imagevector"
 )
 
 // ErrInvalidActuator is an error which is returned when creating an [Actuator]
@@ -73,23 +79,34 @@ const (
 	// baseResourceName is the base name for resources.
 	baseResourceName = "external-otelcol"
 
-	// managedResourceName is the name of the managed resource created by
-	// the actuator.
-	managedResourceName = baseResourceName
+	// ManagedResourceName is the name of the managed resource created by
+	// the actuator, which deploys the collector into the seed cluster.
+	ManagedResourceName = baseResourceName
 
 	// otelCollectorName is the name of the
 	// [otelv1beta1.OpenTelemetryCollector] resource created by the
 	// extension.
 	otelCollectorName = baseResourceName
-	// otelCollectorMetricsPort is the port on which the OTel Collector
+	// CollectorMetricsPort is the port on which the OTel Collector
 	// exposes it's internal metrics.
-	otelCollectorMetricsPort = 8888
-	// otelCollectorReplicas specifies the number of replicas of the OTel
+	CollectorMetricsPort = 8888
+	// CollectorMonitoringServiceName is the name of the Service, which the
+	// OpenTelemetry Operator creates for the internal metrics of the OTel
 	// Collector.
-	otelCollectorReplicas int32 = 1
+	CollectorMonitoringServiceName = otelCollectorName + "-collector-monitoring"
+	// defaultOtelCollectorReplicas specifies the number of replicas of the
+	// OTel Collector, when none is configured.
+	defaultOtelCollectorReplicas int32 = 1
+	// defaultAutoscalerTargetCPUUtilization specifies the target average CPU
+	// utilization of the OTel Collector autoscaler, when neither a CPU nor a
+	// memory utilization target is configured.
+	defaultAutoscalerTargetCPUUtilization int32 = 80
 	// otelCollectorServiceAccountName is the name of the service account
 	// for the OTel Collector.
 	otelCollectorServiceAccountName = otelCollectorName + "-collector"
+	// CollectorStatefulSetName is the name of the StatefulSet, which the
+	// OpenTelemetry Operator creates for the OTel Collector.
+	CollectorStatefulSetName = otelCollectorName + "-collector"
 	// otelCollectorGRPCReceiverPort is the port on which the OTel collector
 	// binds the gRPC receiver.
 	otelCollectorGRPCReceiverPort = 4317
@@ -103,9 +120,9 @@ const (
 	// secretNameClientCertificate is the name of the server certificate of the Target Allocator.
 	secretNameClientCertificate = Name + "-collector-client"
 
-	// targetAllocatorDeploymentName is the name of the deployment for the
+	// TargetAllocatorDeploymentName is the name of the deployment for the
 	// Target Allocator.
-	targetAllocatorDeploymentName = baseResourceName + "-targetallocator"
+	TargetAllocatorDeploymentName = baseResourceName + "-targetallocator"
 	// targetAllocatorHTTPSServiceName is the name of the Kubernetes service for
 	// HTTPS communication of the Target Allocator.
 	targetAllocatorHTTPSServiceName = baseResourceName + "-targetallocator-https"
@@ -115,8 +132,20 @@ const (
 	// targetAllocatorServiceAccountName is the name of the service account
 	// for the Target Allocator.
 	targetAllocatorServiceAccountName = baseResourceName + "-targetallocator"
-	// targetAllocatorReplicas specifies the number of replicas of the Target Allocator.
-	targetAllocatorReplicas int32 = 1
+	// defaultScrapeInterval specifies the interval, in which the targets of
+	// monitors without an explicit interval are scraped, when none is
+	// configured.
+	defaultScrapeInterval = 30 * time.Second
+	// defaultScrapeClassName is the name of the default scrape class of the
+	// Target Allocator, which carries the metric relabeling rules applied to
+	// the targets of all monitors.
+	defaultScrapeClassName = "default"
+	// defaultKubernetesObjectInterval specifies the interval, in which the
+	// Kubernetes objects in pull mode are pulled, when none is configured.
+	defaultKubernetesObjectInterval = time.Hour
+	// defaultTargetAllocatorReplicas specifies the number of replicas of the
+	// Target Allocator, when none is configured.
+	defaultTargetAllocatorReplicas int32 = 1
 	// targetAllocatorRoleName is the name of the Role and RoleBinding
 	// resource for the Target Allocator.
 	targetAllocatorRoleName = baseResourceName + "-targetallocator"
@@ -132,28 +161,79 @@ const (
 	// k8sobjects/events receiver to authenticate to the shoot cluster.
 	shootAccessSecretName = "shoot-access-" + otelCollectorName // #nosec: G101
 
-	// shootManagedResourceName is the name of the ManagedResource that deploys
+	// ShootManagedResourceName is the name of the ManagedResource that deploys
 	// RBAC into the shoot cluster for the k8sobjects/events receiver.
-	shootManagedResourceName = baseResourceName + "-shoot"
+	ShootManagedResourceName = baseResourceName + "-shoot"
+
+	// leaderElectorExtensionName is the name of the k8s_leader_elector
+	// extension, which ensures that only a single replica of the OTel
+	// Collector watches the events of the shoot cluster.
+	leaderElectorExtensionName = "k8s_leader_elector"
+
+	// eventsLeaseName is the name of the Lease in the kube-system namespace
+	// of the shoot cluster, which is used for electing the OTel Collector
+	// replica watching the events.
+	eventsLeaseName = otelCollectorName + "-events"
 
 	// volumeNameShootKubeconfig is the volume name for the shoot kubeconfig
 	// projected into the OTel Collector pod for the k8sobjects/events receiver.
 	volumeNameShootKubeconfig = "shoot-kubeconfig"
 
-	// bearertokenauthextension names used by the exporters.
-	baseBearerTokenAuthName         = "bearertokenauth"
-	httpExporterBearerTokenAuthName = baseBearerTokenAuthName + "/exporter-otlp-http"
-	grpcExporterBearerTokenAuthName = baseBearerTokenAuthName + "/exporter-otlp-grpc"
+	// baseBearerTokenAuthName is the base name of the bearertokenauthextension
+	// instances used by the exporters.
+	baseBearerTokenAuthName = "bearertokenauth"
+
+	// baseVolumeNameTLS is the base name of the TLS volumes for the exporters.
+	baseVolumeNameTLS = "tls"
+
+	// baseVolumeMountPathTLS is the base path of the TLS volume mounts for the
+	// exporters.
+	baseVolumeMountPathTLS = "/etc/ssl/tls"
+
+	// baseVolumeNameBearerToken is the base name of the bearer token volumes
+	// for the exporters.
+	baseVolumeNameBearerToken = "bearer-token-auth" // #nosec: G101
+
+	// baseVolumeMountPathBearerTokenFile is the base path of the bearer token
+	// volume mounts for the exporters.
+	baseVolumeMountPathBearerTokenFile = "/etc/auth/bearer" // #nosec: G101
+
+	// baseVolumeNameWAL is the base name of the Write-Ahead Log volumes for
+	// the exporters.
+	baseVolumeNameWAL = "wal"
+
+	// baseVolumeMountPathWAL is the base path of the Write-Ahead Log volume
+	// mounts for the exporters.
+	baseVolumeMountPathWAL = "/var/lib/otelcol/wal"
 
-	// TLS volume names for the exporters.
-	baseVolumeNameTLS         = "tls"
-	httpExporterVolumeNameTLS = baseVolumeNameTLS + "-exporter-otlp-http"
-	grpcExporterVolumeNameTLS = baseVolumeNameTLS + "-exporter-otlp-grpc"
+	// fileStorageExtensionName is the name of the file_storage extension,
+	// which persists the sending queues of the exporters.
+	fileStorageExtensionName = "file_storage/queue"
 
-	// TLS volume mounts for the exporters.
-	baseVolumeMountPathTLS         = "/etc/ssl/tls"
-	httpExporterVolumeMountPathTLS = baseVolumeMountPathTLS + "-exporter-otlp-http"
-	grpcExporterVolumeMountPathTLS = baseVolumeMountPathTLS + "-exporter-otlp-grpc"
+	// volumeNameFileStorage is the name of the persistent volume, which
+	// backs the file_storage extension.
+	volumeNameFileStorage = "file-storage-queue"
+
+	// volumeMountPathFileStorage is the mount path of the persistent volume,
+	// which backs the file_storage extension.
+	volumeMountPathFileStorage = "/var/lib/otelcol/file-storage/queue"
+
+	// defaultFileStorageSize is the size of the persistent volume, which is
+	// used when none is configured.
+	defaultFileStorageSize = "1Gi"
+
+	// otelCollectorGroupID is the ID of the group, which the OTel Collector
+	// process runs as. It is used as the fsGroup of the collector pods, so
+	// that the persistent volume is writable by the collector.
+	otelCollectorGroupID int64 = 10001
+
+	// envVarKafkaSASLUsername is the name of the environment variable, which
+	// provides the SASL username of the Kafka exporter.
+	envVarKafkaSASLUsername = "KAFKA_EXPORTER_SASL_USERNAME"
+
+	// envVarKafkaSASLPassword is the name of the environment variable, which
+	// provides the SASL password of the Kafka exporter.
+	envVarKafkaSASLPassword = "KAFKA_EXPORTER_SASL_PASSWORD" // #nosec: G101
 
 	// batchProcessorName is the name of the OpenTelemetry Batch processor.
 	batchProcessorName = "batch"
@@ -165,6 +245,34 @@ const (
 	// resourceProcessorName is the name of the OpenTelemetry Resource processor.
 	resourceProcessorName = "resource"
 
+	// probabilisticSamplerProcessorName is the name of the OpenTelemetry
+	// Probabilistic Sampling processor.
+	probabilisticSamplerProcessorName = "probabilistic_sampler"
+
+	// tailSamplingProcessorName is the name of the OpenTelemetry Tail
+	// Sampling processor.
+	tailSamplingProcessorName = "tail_sampling"
+
+	// filterLogsProcessorName is the name of the OpenTelemetry Filter
+	// processor for the logs pipeline.
+	filterLogsProcessorName = "filter/logs"
+
+	// filterMetricsProcessorName is the name of the OpenTelemetry Filter
+	// processor for the metrics pipeline.
+	filterMetricsProcessorName = "filter/metrics"
+
+	// filterTracesProcessorName is the name of the OpenTelemetry Filter
+	// processor for the traces pipeline.
+	filterTracesProcessorName = "filter/traces"
+
+	// filterEventsProcessorName is the name of the OpenTelemetry Filter
+	// processor for the events pipeline.
+	filterEventsProcessorName = "filter/events"
+
+	// annotationKeyChecksumSecretPrefix is the prefix of the pod annotations,
+	// which contain the checksums of the referenced secrets.
+	annotationKeyChecksumSecretPrefix = "checksum/secret-"
+
 	// labelKeyComponent is the standard kubernetes app component label key.
 	labelKeyComponent = "app.kubernetes.io/component"
 	// labelValueTargetAllocator is the component label value identifying the
@@ -199,6 +307,7 @@ type Actuator struct {
 	decoder              runtime.Decoder
 	memoryLimiterConfig  *memorylimiterprocessor.Config
 	batchProcessorConfig *batchprocessor.Config
+	vpaConfig            *VPAConfig
 
 	// The following fields are usually derived from the list of extra Helm
 	// values provided by gardenlet during the deployment of the extension.
@@ -238,6 +347,10 @@ func New(c client.Client, opts ...Option) (*Actuator, error) {
 			Timeout:       5 * time.Second,
 			SendBatchSize: 8192,
 		},
+		vpaConfig: &VPAConfig{
+			Enabled:             true,
+			ControlledResources: []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory},
+		},
 	}
 
 	for _, opt := range opts {
@@ -329,6 +442,23 @@ func WithBatchProcessorConfig(cfg *batchprocessor.Config) Option {
 	return opt
 }
 
+// WithVPAConfig is an [Option], which configures the [Actuator] to deploy the
+// VerticalPodAutoscalers for the OTel Collector and the Target Allocator based
+// on the provided configuration.
+func WithVPAConfig(cfg *VPAConfig) Option {
+	opt := func(a *Actuator) error {
+		if cfg == nil {
+			return errors.New("invalid vpa configuration specified")
+		}
+
+		a.vpaConfig = cfg
+
+		return cfg.Validate()
+	}
+
+	return opt
+}
+
 // Name returns the name of the actuator. This name can be used when registering
 // a controller for the actuator.
 func (a *Actuator) Name() string {
@@ -383,11 +513,6 @@ func (a *Actuator) Reconcile(ctx context.Context, logger logr.Logger, ex *extens
 		return fmt.Errorf("failed to get cluster: %w", err)
 	}
 
-	// Nothing to do here, if the shoot cluster is hibernated at the moment.
-	if v1beta1helper.HibernationIsEnabled(cluster.Shoot) {
-		return nil
-	}
-
 	// Parse and validate the provider config
 	if ex.Spec.ProviderConfig == nil {
 		return errors.New("no provider config specified")
@@ -403,12 +528,13 @@ func (a *Actuator) Reconcile(ctx context.Context, logger logr.Logger, ex *extens
 	}
 
 	// Generate CA and server certificate for Target Allocator
-	if _, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+	caSecret, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
 		Name:       secretNameCACertificate,
 		CommonName: Name,
 		CertType:   secretsutils.CACert,
 		Validity:   ptr.To(30 * 24 * time.Hour),
-	}, secretsmanager.Rotate(secretsmanager.KeepOld), secretsmanager.IgnoreOldSecretsAfter(24*time.Hour)); err != nil {
+	}, secretsmanager.Rotate(secretsmanager.KeepOld), secretsmanager.IgnoreOldSecretsAfter(24*time.Hour))
+	if err != nil {
 		return fmt.Errorf("failed generating CA certificate secret: %w", err)
 	}
 	caBundleSecret, _ := secretsManager.Get(secretNameCACertificate)
@@ -434,14 +560,31 @@ func (a *Actuator) Reconcile(ctx context.Context, logger logr.Logger, ex *extens
 		return fmt.Errorf("failed generating server certificate secret for target allocator: %w", err)
 	}
 
-	taImage, err := imagevector.Images().FindImage(imagevector.ImageNameOTelTargetAllocator)
+	shootAccessSecret := gardenerutils.NewShootAccessSecret(shootAccessSecretName, ex.Namespace)
+	if err := shootAccessSecret.Reconcile(ctx, a.client); err != nil {
+		return fmt.Errorf("failed reconciling shoot access secret: %w", err)
+	}
+
+	// The shoot-side objects and the checksums of the referenced secrets are
+	// reconciled during hibernation as well. The referenced secrets are read
+	// from the seed, and the shoot managed resource is only applied by the
+	// resource manager once the shoot is woken up. Keeping both up to date
+	// ensures that the collector pods are rolled out exactly once on wake-up,
+	// and that the RBAC in the shoot is not removed in the meantime.
+	referencedSecretChecksums, err := a.getReferencedSecretChecksums(ctx, ex.Namespace, cfg, cluster.Shoot.Spec.Resources)
 	if err != nil {
-		return fmt.Errorf("failed to find image: %w", err)
+		return err
 	}
 
-	collectorImage, err := imagevector.Images().FindImage(imagevector.ImageNameOTelCollector)
+	result, err := a.renderObjects(ex.Namespace, cfg, cluster, renderSecrets{
+		caBundle:            caBundleSecret,
+		server:              serverSecret,
+		client:              clientSecret,
+		shootAccess:         shootAccessSecret,
+		referencedChecksums: referencedSecretChecksums,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to find image: %w", err)
+		return err
 	}
 
 	// Bundle things up in a managed resource
@@ -451,38 +594,7 @@ func (a *Actuator) Reconcile(ctx context.Context, logger logr.Logger, ex *extens
 		kubernetes.SeedSerializer,
 	)
 
-	taConfigMap, err := a.getTargetAllocatorConfigMap(ex.Namespace)
-	if err != nil {
-		return err
-	}
-
-	shootKubeconfigSecretName := extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster)
-
-	shootAccessSecret := gardenerutils.NewShootAccessSecret(shootAccessSecretName, ex.Namespace)
-	if err := shootAccessSecret.Reconcile(ctx, a.client); err != nil {
-		return fmt.Errorf("failed reconciling shoot access secret: %w", err)
-	}
-
-	data, err := registry.AddAllAndSerialize(
-		taConfigMap,
-		a.getTargetAllocatorServiceAccount(ex.Namespace),
-		a.getTargetAllocatorRole(ex.Namespace),
-		a.getTargetAllocatorRoleBinding(ex.Namespace),
-		a.getTargetAllocatorHTTPSService(ex.Namespace),
-		a.getTargetAllocatorDeployment(ex.Namespace, caBundleSecret, serverSecret, taImage),
-		a.getOtelCollectorServiceAccount(ex.Namespace),
-		a.getOtelCollector(
-			ex.Namespace,
-			caBundleSecret,
-			clientSecret,
-			cfg,
-			cluster.Shoot.Spec.Resources,
-			shootKubeconfigSecretName,
-			shootAccessSecret.Secret.Name,
-			collectorImage,
-		),
-	)
-
+	data, err := registry.AddAllAndSerialize(result.Seed...)
 	if err != nil {
 		return err
 	}
@@ -493,26 +605,42 @@ func (a *Actuator) Reconcile(ctx context.Context, logger logr.Logger, ex *extens
 		kubernetes.ShootSerializer,
 	)
 
-	shootData, err := shootRegistry.AddAllAndSerialize(
-		a.getEventsClusterRole(),
-		a.getEventsClusterRoleBinding(shootAccessSecret.ServiceAccountName),
-	)
+	shootData, err := shootRegistry.AddAllAndSerialize(result.Shoot...)
 	if err != nil {
 		return err
 	}
 
-	if err := managedresources.CreateForShoot(ctx, a.client, ex.Namespace, shootManagedResourceName, Name, false, shootData); err != nil {
+	if err := managedresources.CreateForShoot(ctx, a.client, ex.Namespace, ShootManagedResourceName, Name, false, shootData); err != nil {
 		return fmt.Errorf("failed creating shoot managed resource: %w", err)
 	}
 
-	return managedresources.CreateForSeed(
+	if err := managedresources.CreateForSeed(
 		ctx,
 		a.client,
 		ex.Namespace,
-		managedResourceName,
+		ManagedResourceName,
 		false,
 		data,
+	); err != nil {
+		return err
+	}
+
+	status, err := getCollectorStatus(
+		cfg,
+		result.collector,
+		result.collectorImage,
+		result.taImage,
+		map[string][]byte{
+			secretNameCACertificate:     caSecret.Data[secretsutils.DataKeyCertificateCA],
+			secretNameServerCertificate: serverSecret.Data[secretsutils.DataKeyCertificate],
+			secretNameClientCertificate: clientSecret.Data[secretsutils.DataKeyCertificate],
+		},
 	)
+	if err != nil {
+		return err
+	}
+
+	return a.updateProviderStatus(ctx, ex, status)
 }
 
 // Delete deletes any resources managed by the [Actuator]. This method
@@ -529,11 +657,11 @@ func (a *Actuator) Delete(ctx context.Context, logger logr.Logger, ex *extension
 		return fmt.Errorf("failed cleaning up secrets managed by secrets manager: %w", err)
 	}
 
-	if err := client.IgnoreNotFound(managedresources.DeleteForShoot(ctx, a.client, ex.Namespace, shootManagedResourceName)); err != nil {
+	if err := client.IgnoreNotFound(managedresources.DeleteForShoot(ctx, a.client, ex.Namespace, ShootManagedResourceName)); err != nil {
 		return fmt.Errorf("failed deleting shoot managed resource: %w", err)
 	}
 
-	if err := managedresources.WaitUntilDeleted(ctx, a.client, ex.Namespace, shootManagedResourceName); err != nil {
+	if err := managedresources.WaitUntilDeleted(ctx, a.client, ex.Namespace, ShootManagedResourceName); err != nil {
 		return fmt.Errorf("failed waiting for shoot managed resource to be deleted: %w", err)
 	}
 
@@ -541,7 +669,7 @@ func (a *Actuator) Delete(ctx context.Context, logger logr.Logger, ex *extension
 		return fmt.Errorf("failed deleting shoot access secret: %w", err)
 	}
 
-	return client.IgnoreNotFound(managedresources.DeleteForSeed(ctx, a.client, ex.Namespace, managedResourceName))
+	return client.IgnoreNotFound(managedresources.DeleteForSeed(ctx, a.client, ex.Namespace, ManagedResourceName))
 }
 
 // ForceDelete signals the [Actuator] to delete any resources managed by it,
@@ -567,14 +695,113 @@ func (a *Actuator) Restore(ctx context.Context, logger logr.Logger, ex *extensio
 // target seed can pick them up after migration. SetKeepObjects prevents the
 // ManagedResource controller from deleting them when the ManagedResource is
 // removed from the old seed.
+//
+// Persistent sending queues of the exporters are not migrated. The persistent
+// volumes backing them are bound to the old seed, and are deleted along with
+// the collector. Any data, which has not been exported until then, is lost.
 func (a *Actuator) Migrate(ctx context.Context, logger logr.Logger, ex *extensionsv1alpha1.Extension) error {
-	if err := managedresources.SetKeepObjects(ctx, a.client, ex.Namespace, shootManagedResourceName, true); err != nil {
+	if err := managedresources.SetKeepObjects(ctx, a.client, ex.Namespace, ShootManagedResourceName, true); err != nil {
 		return fmt.Errorf("failed setting keep-objects on shoot managed resource: %w", err)
 	}
 
 	return a.Delete(ctx, logger, ex)
 }
 
+// getSeedObjects returns the objects, which are deployed into the shoot
+// namespace of the seed cluster for the given collector.
+func (a *Actuator) getSeedObjects(
+	namespace string,
+	collector *otelv1beta1.OpenTelemetryCollector,
+	caBundleSecret, serverSecret *corev1.Secret,
+	taImage *imagevectorutils.Image,
+	cfg config.CollectorConfig,
+) ([]client.Object, error) {
+	scaling := cfg.Spec.Scaling
+	taConfigMap, err := a.getTargetAllocatorConfigMap(namespace, cfg.Spec.Metrics.Scrape)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []client.Object{
+		taConfigMap,
+		a.getTargetAllocatorServiceAccount(namespace),
+		a.getTargetAllocatorRole(namespace),
+		a.getTargetAllocatorRoleBinding(namespace),
+		a.getTargetAllocatorHTTPSService(namespace),
+		a.getTargetAllocatorDeployment(namespace, caBundleSecret, serverSecret, taImage, scaling.TargetAllocator),
+		a.getOtelCollectorServiceAccount(namespace),
+		collector,
+	}
+
+	// Keep at least one Target Allocator running during voluntary
+	// disruptions, when there is more than one replica.
+	if getReplicas(scaling.TargetAllocator.Replicas, defaultTargetAllocatorReplicas) > 1 {
+		objects = append(objects, a.getTargetAllocatorPodDisruptionBudget(namespace))
+	}
+
+	for _, vpa := range a.getVPAs(namespace, scaling.Collector) {
+		objects = append(objects, vpa)
+	}
+
+	return objects, nil
+}
+
+// scaleDownForHibernation scales the collector and the Target Allocator in the
+// given seed objects down to zero replicas. The horizontal pod autoscaler of
+// the collector is dropped, so that it does not scale the collector up again.
+//
+// The secrets and the remaining objects are kept, so that the collector can
+// be started again without regenerating any of them.
+func scaleDownForHibernation(objects []client.Object) {
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			o.Spec.Replicas = new(int32(0))
+		case *otelv1beta1.OpenTelemetryCollector:
+			o.Spec.Replicas = new(int32(0))
+			o.Spec.Autoscaler = nil
+		}
+	}
+}
+
+// getShootObjects returns the objects, which are deployed into the shoot
+// cluster for the service account with the given name.
+//
+// The service account is granted the least privileges required for
+// collecting the configured Kubernetes objects, i.e. objects collected from
+// all namespaces are granted via a ClusterRole, while objects collected from
+// specific namespaces are granted via a Role in each of the namespaces.
+func (a *Actuator) getShootObjects(serviceAccountName string, cfg config.CollectorConfig) []client.Object {
+	objects := make([]client.Object, 0)
+
+	clusterRules, namespacedRules := getKubernetesObjectsPolicyRules(getKubernetesObjects(cfg))
+	if len(clusterRules) > 0 {
+		objects = append(
+			objects,
+			a.getKubernetesObjectsClusterRole(clusterRules),
+			a.getKubernetesObjectsClusterRoleBinding(serviceAccountName),
+		)
+	}
+
+	for _, namespace := range slices.Sorted(maps.Keys(namespacedRules)) {
+		objects = append(
+			objects,
+			a.getKubernetesObjectsRole(namespace, namespacedRules[namespace]),
+			a.getKubernetesObjectsRoleBinding(namespace, serviceAccountName),
+		)
+	}
+
+	if runsMultipleReplicas(cfg.Spec.Scaling.Collector) {
+		objects = append(
+			objects,
+			a.getEventsLeaseRole(),
+			a.getEventsLeaseRoleBinding(serviceAccountName),
+		)
+	}
+
+	return objects
+}
+
 func (a *Actuator) newSecretsManager(ctx context.Context, log logr.Logger, namespace string) (secretsmanager.Interface, error) {
 	return secretsmanager.New(
 		ctx,
@@ -624,7 +851,7 @@ func (a *Actuator) getAnnotations() map[string]string {
 	fromAllScrapeTargetsAnnotation := resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "from-all-scrape-targets-allowed-ports"
 
 	items := map[string]string{
-		fromAllScrapeTargetsAnnotation: fmt.Sprintf(`[{"protocol":"TCP","port":%d},{"protocol":"TCP","port":%d}]`, otelCollectorMetricsPort, otelCollectorGRPCReceiverPort),
+		fromAllScrapeTargetsAnnotation: fmt.Sprintf(`[{"protocol":"TCP","port":%d},{"protocol":"TCP","port":%d}]`, CollectorMetricsPort, otelCollectorGRPCReceiverPort),
 	}
 
 	return items
@@ -670,9 +897,40 @@ func (a *Actuator) getTargetAllocatorHTTPSService(namespace string) *corev1.Serv
 
 // getTargetAllocatorConfigMap returns the [corev1.ConfigMap] for the Target
 // Allocator.
-func (a *Actuator) getTargetAllocatorConfigMap(namespace string) (*corev1.ConfigMap, error) {
+func (a *Actuator) getTargetAllocatorConfigMap(namespace string, cfg config.MetricsScrapeConfig) (*corev1.ConfigMap, error) {
+	allocationStrategy := cmp.Or(cfg.AllocationStrategy, config.AllocationStrategyConsistentHashing)
+	scrapeInterval := cmp.Or(cfg.Interval, defaultScrapeInterval)
+
+	serviceMonitorSelector := cfg.ServiceMonitorSelector
+	if serviceMonitorSelector == nil {
+		serviceMonitorSelector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				configKeyPrometheus: labelValuePrometheusShoot,
+			},
+		}
+	}
+
+	// Unset selectors are omitted, so that they are rendered as null, which
+	// selects no resources, instead of an empty selector, which selects all.
+	selectors := make(map[string]any)
+	for key, selector := range map[string]*metav1.LabelSelector{
+		"service_monitor_selector": serviceMonitorSelector,
+		"pod_monitor_selector":     cfg.PodMonitorSelector,
+		"scrape_config_selector":   cfg.ScrapeConfigSelector,
+	} {
+		if selector == nil {
+			continue
+		}
+
+		val, err := labelSelectorToMap(selector)
+		if err != nil {
+			return nil, err
+		}
+		selectors[key] = val
+	}
+
 	taConfig := map[string]any{
-		"allocation_strategy":              otelv1alpha1.OpenTelemetryTargetAllocatorAllocationStrategyConsistentHashing,
+		"allocation_strategy":              string(allocationStrategy),
 		"collector_not_ready_grace_period": 30 * time.Second,
 		"collector_namespace":              namespace,
 		"collector_selector": map[string]any{
@@ -686,21 +944,30 @@ func (a *Actuator) getTargetAllocatorConfigMap(namespace string) (*corev1.Config
 		},
 		"filter_strategy": "relabel-config",
 		"prometheus_cr": map[string]any{
-			configKeyEnabled:         true,
-			"allow_namespaces":       []string{namespace},
-			"scrape_interval":        30 * time.Second,
-			"scrape_config_selector": nil,
-			"probe_selector":         nil,
-			"pod_monitor_selector":   nil,
-			"deny_namespaces":        nil,
-			"service_monitor_selector": map[string]any{
-				"matchLabels": map[string]any{
-					configKeyPrometheus: labelValuePrometheusShoot,
-				},
-			},
+			configKeyEnabled:           true,
+			"allow_namespaces":         []string{namespace},
+			"scrape_interval":          scrapeInterval,
+			"scrape_config_selector":   selectors["scrape_config_selector"],
+			"probe_selector":           nil,
+			"pod_monitor_selector":     selectors["pod_monitor_selector"],
+			"deny_namespaces":          nil,
+			"service_monitor_selector": selectors["service_monitor_selector"],
 		},
 	}
 
+	// The metric relabeling rules are attached to a default scrape class,
+	// which applies to all monitors not referencing another scrape class.
+	if len(cfg.MetricRelabelConfigs) > 0 {
+		prometheusCR := taConfig["prometheus_cr"].(map[string]any)
+		prometheusCR["scrape_classes"] = []any{
+			map[string]any{
+				"name":              defaultScrapeClassName,
+				"default":           true,
+				"metricRelabelings": getScrapeClassRelabelings(cfg.MetricRelabelConfigs),
+			},
+		}
+	}
+
 	data, err := yaml.Marshal(taConfig)
 	if err != nil {
 		return nil, err
@@ -720,6 +987,87 @@ func (a *Actuator) getTargetAllocatorConfigMap(namespace string) (*corev1.Config
 	return configMap, nil
 }
 
+// getRelabelConfigs returns the given relabeling rules in the format of the
+// Prometheus configuration, which is keyed by snake_case field names. Fields,
+// which are not specified, are omitted, so that the Prometheus defaults
+// apply.
+func getRelabelConfigs(relabelConfigs []config.RelabelConfig) []any {
+	result := make([]any, 0, len(relabelConfigs))
+	for _, relabelConfig := range relabelConfigs {
+		item := map[string]any{
+			"action": string(cmp.Or(relabelConfig.Action, config.RelabelActionReplace)),
+		}
+
+		if len(relabelConfig.SourceLabels) > 0 {
+			item["source_labels"] = relabelConfig.SourceLabels
+		}
+		if relabelConfig.Separator != nil {
+			item["separator"] = *relabelConfig.Separator
+		}
+		if relabelConfig.TargetLabel != "" {
+			item["target_label"] = relabelConfig.TargetLabel
+		}
+		if relabelConfig.Regex != "" {
+			item["regex"] = relabelConfig.Regex
+		}
+		if relabelConfig.Modulus != 0 {
+			item["modulus"] = relabelConfig.Modulus
+		}
+		if relabelConfig.Replacement != nil {
+			item["replacement"] = *relabelConfig.Replacement
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// getScrapeClassRelabelings returns the given relabeling rules in the format
+// of the Prometheus Operator scrape classes, which is keyed by camelCase
+// field names.
+func getScrapeClassRelabelings(relabelConfigs []config.RelabelConfig) []any {
+	keys := map[string]string{
+		"source_labels": "sourceLabels",
+		"target_label":  "targetLabel",
+	}
+
+	result := getRelabelConfigs(relabelConfigs)
+	for _, item := range result {
+		item := item.(map[string]any)
+		for from, to := range keys {
+			if val, ok := item[from]; ok {
+				delete(item, from)
+				item[to] = val
+			}
+		}
+	}
+
+	return result
+}
+
+// labelSelectorToMap converts the given [metav1.LabelSelector] into a map,
+// which is keyed by the JSON field names of the selector, as expected by the
+// Target Allocator configuration. A nil selector is returned as nil, which
+// does not select any objects.
+func labelSelectorToMap(selector *metav1.LabelSelector) (map[string]any, error) {
+	if selector == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // getTargetAllocatorRole returns the [rbacv1.Role] for the Target Allocator.
 func (a *Actuator) getTargetAllocatorRole(namespace string) *rbacv1.Role {
 	return &rbacv1.Role{
@@ -802,7 +1150,12 @@ func (a *Actuator) getTargetAllocatorRoleBinding(namespace string) *rbacv1.RoleB
 // - Deployment for the TargetAllocator (getTargetAllocatorDeployment)
 // - ConfigMap for the TargetAllocator (getTargetAllocatorConfigMap)
 // - HTTPS Service for the Target Allocator (getTargetAllocatorHTTPSService)
-func (a *Actuator) getTargetAllocatorDeployment(namespace string, caSecret, serverSecret *corev1.Secret, image *imagevectorutils.Image) *appsv1.Deployment {
+func (a *Actuator) getTargetAllocatorDeployment(
+	namespace string,
+	caSecret, serverSecret *corev1.Secret,
+	image *imagevectorutils.Image,
+	scaling config.TargetAllocatorScalingConfig,
+) *appsv1.Deployment {
 	const (
 		volumeNameCACertificate      = "ca-cert"
 		volumeMountPathCACertificate = "/etc/ssl/certs/ca"
@@ -814,22 +1167,16 @@ func (a *Actuator) getTargetAllocatorDeployment(namespace string, caSecret, serv
 		volumeMountTargetAllocatorConfig = "/app/targetallocator"
 	)
 
-	allLabels := utils.MergeStringMaps(
-		a.getCommonLabels(),
-		a.getNetworkLabels(),
-		map[string]string{
-			labelKeyComponent: labelValueTargetAllocator,
-		},
-	)
+	allLabels := a.getTargetAllocatorPodLabels()
 
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      targetAllocatorDeploymentName,
+			Name:      TargetAllocatorDeploymentName,
 			Namespace: namespace,
 			Labels:    a.getCommonLabels(),
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas:             new(targetAllocatorReplicas),
+			Replicas:             new(getReplicas(scaling.Replicas, defaultTargetAllocatorReplicas)),
 			RevisionHistoryLimit: ptr.To[int32](2),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: allLabels,
@@ -858,12 +1205,7 @@ func (a *Actuator) getTargetAllocatorDeployment(namespace string, caSecret, serv
 								fmt.Sprintf("--https-tls-cert-file=%s/%s", volumeMountPathServerCertificate, secretsutils.DataKeyCertificate),
 								fmt.Sprintf("--https-tls-key-file=%s/%s", volumeMountPathServerCertificate, secretsutils.DataKeyPrivateKey),
 							},
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("10m"),
-									corev1.ResourceMemory: resource.MustParse("50Mi"),
-								},
-							},
+							Resources: getResourceRequirements(scaling.Resources),
 							VolumeMounts: []corev1.VolumeMount{
 								{Name: volumeNameCACertificate, MountPath: volumeMountPathCACertificate, ReadOnly: true},
 								{Name: volumeNameServerCertificate, MountPath: volumeMountPathServerCertificate, ReadOnly: true},
@@ -885,6 +1227,37 @@ func (a *Actuator) getTargetAllocatorDeployment(namespace string, caSecret, serv
 	}
 }
 
+// getTargetAllocatorPodLabels returns the labels of the Target Allocator pods.
+func (a *Actuator) getTargetAllocatorPodLabels() map[string]string {
+	return utils.MergeStringMaps(
+		a.getCommonLabels(),
+		a.getNetworkLabels(),
+		map[string]string{
+			labelKeyComponent: labelValueTargetAllocator,
+		},
+	)
+}
+
+// getTargetAllocatorPodDisruptionBudget returns the
+// [policyv1.PodDisruptionBudget] for the Target Allocator, which allows at
+// most one of its pods to be unavailable during voluntary disruptions.
+func (a *Actuator) getTargetAllocatorPodDisruptionBudget(namespace string) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TargetAllocatorDeploymentName,
+			Namespace: namespace,
+			Labels:    a.getCommonLabels(),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: a.getTargetAllocatorPodLabels(),
+			},
+			UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+		},
+	}
+}
+
 // getOtelCollectorServiceAccount returns the [corev1.ServiceAccount] for the
 // the OTel Collector.
 func (a *Actuator) getOtelCollectorServiceAccount(namespace string) *corev1.ServiceAccount {
@@ -914,8 +1287,8 @@ func (a *Actuator) getDebugExporterConfig(cfg config.DebugExporterConfig) map[st
 }
 
 // getOTLPHTTPExporterConfig returns the OTel settings for the OTLP HTTP
-// exporter.
-func (a *Actuator) getOTLPHTTPExporterConfig(cfg config.OTLPHTTPExporterConfig) map[string]any {
+// exporter with the given id.
+func (a *Actuator) getOTLPHTTPExporterConfig(id string, cfg config.OTLPHTTPExporterConfig) map[string]any {
 	exporter := map[string]any{}
 
 	// See the link below for more details about each config setting of the
@@ -950,40 +1323,23 @@ func (a *Actuator) getOTLPHTTPExporterConfig(cfg config.OTLPHTTPExporterConfig)
 
 	// Retry on Failure settings
 	if cfg.RetryOnFailure.Enabled != nil {
-		exporter["retry_on_failure"] = map[string]any{
-			configKeyEnabled:   *cfg.RetryOnFailure.Enabled,
-			"initial_interval": cfg.RetryOnFailure.InitialInterval.String(),
-			"max_interval":     cfg.RetryOnFailure.MaxInterval.String(),
-			"max_elapsed_time": cfg.RetryOnFailure.MaxElapsedTime.String(),
-			"multiplier":       cfg.RetryOnFailure.Multiplier,
-		}
+		exporter["retry_on_failure"] = getRetryOnFailureConfig(cfg.RetryOnFailure)
 	}
 
-	// TLS settings
-	if tls := cfg.TLS; tls != nil {
-		tlsConfig := map[string]any{}
-		if tls.InsecureSkipVerify != nil {
-			tlsConfig["insecure_skip_verify"] = *tls.InsecureSkipVerify
-		}
-		if tls.CA != nil {
-			tlsConfig["ca_file"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.CA.ResourceRef.DataKey)
-		}
-		if tls.Cert != nil {
-			tlsConfig["cert_file"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.Cert.ResourceRef.DataKey)
-		}
-		if tls.Key != nil {
-			tlsConfig["key_file"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.Key.ResourceRef.DataKey)
-		}
-
-		tlsConfig["reload_interval"] = tls.ReloadInterval.String()
+	// Sending Queue settings
+	if cfg.SendingQueue.Enabled != nil {
+		exporter["sending_queue"] = getSendingQueueConfig(cfg.SendingQueue)
+	}
 
-		exporter["tls"] = tlsConfig
+	// TLS settings
+	if cfg.TLS != nil {
+		exporter["tls"] = getExporterTLSConfig(id, cfg.TLS)
 	}
 
 	// Bearer Token Authentication settings
 	if cfg.Token != nil {
 		exporter["auth"] = map[string]any{
-			"authenticator": httpExporterBearerTokenAuthName,
+			"authenticator": exporterBearerTokenAuthName(id),
 		}
 	}
 
@@ -991,8 +1347,8 @@ func (a *Actuator) getOTLPHTTPExporterConfig(cfg config.OTLPHTTPExporterConfig)
 }
 
 // getOTLPGRPCExporterConfig returns the OTel settings for the OTLP gRPC
-// exporter.
-func (a *Actuator) getOTLPGRPCExporterConfig(cfg config.OTLPGRPCExporterConfig) map[string]any {
+// exporter with the given id.
+func (a *Actuator) getOTLPGRPCExporterConfig(id string, cfg config.OTLPGRPCExporterConfig) map[string]any {
 	// See the link below for more details about each config setting of the
 	// OTLP gRPC exporter.
 	//
@@ -1007,91 +1363,736 @@ func (a *Actuator) getOTLPGRPCExporterConfig(cfg config.OTLPGRPCExporterConfig)
 
 	// Retry on Failure settings
 	if cfg.RetryOnFailure.Enabled != nil {
-		exporter["retry_on_failure"] = map[string]any{
-			configKeyEnabled:   *cfg.RetryOnFailure.Enabled,
-			"initial_interval": cfg.RetryOnFailure.InitialInterval.String(),
-			"max_interval":     cfg.RetryOnFailure.MaxInterval.String(),
-			"max_elapsed_time": cfg.RetryOnFailure.MaxElapsedTime.String(),
-			"multiplier":       cfg.RetryOnFailure.Multiplier,
-		}
+		exporter["retry_on_failure"] = getRetryOnFailureConfig(cfg.RetryOnFailure)
+	}
+
+	// Sending Queue settings
+	if cfg.SendingQueue.Enabled != nil {
+		exporter["sending_queue"] = getSendingQueueConfig(cfg.SendingQueue)
 	}
 
 	// TLS settings
-	if tls := cfg.TLS; tls != nil {
-		tlsConfig := map[string]any{}
-		if tls.InsecureSkipVerify != nil {
-			tlsConfig["insecure_skip_verify"] = *tls.InsecureSkipVerify
-		}
-		if tls.CA != nil {
-			tlsConfig["ca_file"] = filepath.Join(grpcExporterVolumeMountPathTLS, tls.CA.ResourceRef.DataKey)
-		}
-		if tls.Cert != nil {
-			tlsConfig["cert_file"] = filepath.Join(grpcExporterVolumeMountPathTLS, tls.Cert.ResourceRef.DataKey)
+	if cfg.TLS != nil {
+		exporter["tls"] = getExporterTLSConfig(id, cfg.TLS)
+	}
+
+	// Bearer Token Authentication settings
+	if cfg.Token != nil {
+		exporter["auth"] = map[string]any{
+			"authenticator": exporterBearerTokenAuthName(id),
 		}
-		if tls.Key != nil {
-			tlsConfig["key_file"] = filepath.Join(grpcExporterVolumeMountPathTLS, tls.Key.ResourceRef.DataKey)
+	}
+
+	return exporter
+}
+
+// getPrometheusRemoteWriteExporterConfig returns the OTel settings for the
+// Prometheus Remote Write exporter.
+func (a *Actuator) getPrometheusRemoteWriteExporterConfig(cfg config.PrometheusRemoteWriteExporterConfig) map[string]any {
+	// See the link below for more details about each config setting of the
+	// Prometheus Remote Write exporter.
+	//
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/prometheusremotewriteexporter
+	id := config.ExporterNamePrometheusRemoteWrite
+	exporter := map[string]any{
+		configKeyEndpoint: cfg.Endpoint,
+		"timeout":         cfg.Timeout.String(),
+	}
+
+	if len(cfg.ExternalLabels) > 0 {
+		exporter["external_labels"] = cfg.ExternalLabels
+	}
+
+	// Write-Ahead Log settings
+	if cfg.WAL.IsEnabled() {
+		exporter["wal"] = map[string]any{
+			"directory":          exporterVolumeMountPathWAL(id),
+			"buffer_size":        cfg.WAL.BufferSize,
+			"truncate_frequency": cfg.WAL.TruncateFrequency.String(),
 		}
+	}
 
-		tlsConfig["reload_interval"] = tls.ReloadInterval.String()
+	// Retry on Failure settings
+	if cfg.RetryOnFailure.Enabled != nil {
+		exporter["retry_on_failure"] = getRetryOnFailureConfig(cfg.RetryOnFailure)
+	}
 
-		exporter["tls"] = tlsConfig
+	// TLS settings
+	if cfg.TLS != nil {
+		exporter["tls"] = getExporterTLSConfig(id, cfg.TLS)
 	}
 
 	// Bearer Token Authentication settings
 	if cfg.Token != nil {
 		exporter["auth"] = map[string]any{
-			"authenticator": grpcExporterBearerTokenAuthName,
+			"authenticator": exporterBearerTokenAuthName(id),
 		}
 	}
 
 	return exporter
 }
 
-// getOtelExporters returns the OpenTelemetry exporters based on the given
-// [config.CollectorConfig] spec.
-func (a *Actuator) getOtelExporters(cfg config.CollectorConfig) map[string]any {
-	exporters := make(map[string]any)
+// getKafkaExporterConfig returns the OTel settings for the Kafka exporter.
+func (a *Actuator) getKafkaExporterConfig(cfg config.KafkaExporterConfig) map[string]any {
+	// See the link below for more details about each config setting of the
+	// Kafka exporter.
+	//
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/kafkaexporter
+	exporter := map[string]any{
+		"brokers": cfg.Brokers,
+		"timeout": cfg.Timeout.String(),
+		"logs": map[string]any{
+			"topic":    cfg.Topics.Logs,
+			"encoding": string(cfg.Encoding),
+		},
+		"metrics": map[string]any{
+			"topic":    cfg.Topics.Metrics,
+			"encoding": string(cfg.Encoding),
+		},
+		"traces": map[string]any{
+			"topic":    cfg.Topics.Traces,
+			"encoding": string(cfg.Encoding),
+		},
+	}
 
-	if cfg.Spec.Exporters.DebugExporter.IsEnabled() {
-		exporters["debug"] = a.getDebugExporterConfig(cfg.Spec.Exporters.DebugExporter)
+	// Retry on Failure settings
+	if cfg.RetryOnFailure.Enabled != nil {
+		exporter["retry_on_failure"] = getRetryOnFailureConfig(cfg.RetryOnFailure)
 	}
 
-	if cfg.Spec.Exporters.OTLPHTTPExporter.IsEnabled() {
-		exporters["otlp_http"] = a.getOTLPHTTPExporterConfig(cfg.Spec.Exporters.OTLPHTTPExporter)
+	// TLS settings
+	if cfg.TLS != nil {
+		exporter["tls"] = getExporterTLSConfig(config.ExporterNameKafka, cfg.TLS)
 	}
 
-	if cfg.Spec.Exporters.OTLPGRPCExporter.IsEnabled() {
-		exporters["otlp_grpc"] = a.getOTLPGRPCExporterConfig(cfg.Spec.Exporters.OTLPGRPCExporter)
+	// SASL Authentication settings. The credentials are provided via
+	// environment variables, which are sourced from the referenced secrets.
+	if cfg.SASL != nil {
+		exporter["auth"] = map[string]any{
+			"sasl": map[string]any{
+				"mechanism": string(cfg.SASL.Mechanism),
+				"username":  "${env:" + envVarKafkaSASLUsername + "}",
+				"password":  "${env:" + envVarKafkaSASLPassword + "}",
+			},
+		}
 	}
 
-	return exporters
+	return exporter
 }
 
-// parseShootNamespaceAttributes extracts OTel resource attributes from a shoot
-// namespace name of the form "shoot--<project>--<shoot>".
-// The full namespace name maps to k8s.cluster.name; the two segments map to
-// gardener.project.name and gardener.shoot.name respectively.
-// For namespaces that do not follow the pattern, projectName and shootName are
-// returned as empty strings.
-func parseShootNamespaceAttributes(namespace string) (clusterName, projectName, shootName string) {
-	clusterName = namespace
-	parts := strings.SplitN(namespace, "--", 3)
-	if len(parts) == 3 {
-		projectName = parts[1]
-		shootName = parts[2]
+// getSendingQueueConfig returns the OTel sending queue settings of an
+// exporter.
+func getSendingQueueConfig(cfg config.SendingQueueConfig) map[string]any {
+	queue := map[string]any{
+		configKeyEnabled: cfg.IsEnabled(),
+		"num_consumers":  cfg.NumConsumers,
+		"queue_size":     cfg.QueueSize,
 	}
 
-	return clusterName, projectName, shootName
+	if cfg.IsPersistent() {
+		queue["storage"] = fileStorageExtensionName
+	}
+
+	return queue
 }
 
-// getOTelCollector returns the [otelv1beta1.OpenTelemetryCollector]
-// resource, which the extension manages.
-func (a *Actuator) getOtelCollector(
-	namespace string,
-	caSecret, clientSecret *corev1.Secret,
-	cfg config.CollectorConfig,
-	resources []gardencorev1beta1.NamedResourceReference,
-	shootKubeconfigSecretName string,
+// usesFileStorage is a predicate which returns whether any of the enabled
+// exporters persists its sending queue in the file storage.
+func usesFileStorage(cfg config.CollectorConfig) bool {
+	exporters := cfg.Spec.Exporters
+	queues := make([]config.SendingQueueConfig, 0)
+
+	if exporters.OTLPHTTPExporter.IsEnabled() {
+		queues = append(queues, exporters.OTLPHTTPExporter.SendingQueue)
+	}
+
+	if exporters.OTLPGRPCExporter.IsEnabled() {
+		queues = append(queues, exporters.OTLPGRPCExporter.SendingQueue)
+	}
+
+	for _, exporter := range exporters.NamedOTLPHTTPExporters {
+		if exporter.IsEnabled() {
+			queues = append(queues, exporter.SendingQueue)
+		}
+	}
+
+	for _, exporter := range exporters.NamedOTLPGRPCExporters {
+		if exporter.IsEnabled() {
+			queues = append(queues, exporter.SendingQueue)
+		}
+	}
+
+	return slices.ContainsFunc(queues, config.SendingQueueConfig.IsPersistent)
+}
+
+// getRetryOnFailureConfig returns the OTel retry settings of an exporter.
+func getRetryOnFailureConfig(cfg config.RetryOnFailureConfig) map[string]any {
+	return map[string]any{
+		configKeyEnabled:   ptr.Deref(cfg.Enabled, false),
+		"initial_interval": cfg.InitialInterval.String(),
+		"max_interval":     cfg.MaxInterval.String(),
+		"max_elapsed_time": cfg.MaxElapsedTime.String(),
+		"multiplier":       cfg.Multiplier,
+	}
+}
+
+// getOtelExporters returns the OpenTelemetry exporters based on the given
+// [config.CollectorConfig] spec.
+func (a *Actuator) getOtelExporters(cfg config.CollectorConfig) map[string]any {
+	exporters := make(map[string]any)
+
+	if cfg.Spec.Exporters.DebugExporter.IsEnabled() {
+		exporters[config.ExporterNameDebug] = a.getDebugExporterConfig(cfg.Spec.Exporters.DebugExporter)
+	}
+
+	if cfg.Spec.Exporters.OTLPHTTPExporter.IsEnabled() {
+		exporters[config.ExporterNameOTLPHTTP] = a.getOTLPHTTPExporterConfig(config.ExporterNameOTLPHTTP, cfg.Spec.Exporters.OTLPHTTPExporter)
+	}
+
+	if cfg.Spec.Exporters.OTLPGRPCExporter.IsEnabled() {
+		exporters[config.ExporterNameOTLPGRPC] = a.getOTLPGRPCExporterConfig(config.ExporterNameOTLPGRPC, cfg.Spec.Exporters.OTLPGRPCExporter)
+	}
+
+	if cfg.Spec.Exporters.PrometheusRemoteWriteExporter.IsEnabled() {
+		exporters[config.ExporterNamePrometheusRemoteWrite] = a.getPrometheusRemoteWriteExporterConfig(cfg.Spec.Exporters.PrometheusRemoteWriteExporter)
+	}
+
+	if cfg.Spec.Exporters.KafkaExporter.IsEnabled() {
+		exporters[config.ExporterNameKafka] = a.getKafkaExporterConfig(cfg.Spec.Exporters.KafkaExporter)
+	}
+
+	for _, exporter := range cfg.Spec.Exporters.NamedOTLPHTTPExporters {
+		if exporter.IsEnabled() {
+			id := config.ExporterID(config.ExporterNameOTLPHTTP, exporter.Name)
+			exporters[id] = a.getOTLPHTTPExporterConfig(id, exporter.OTLPHTTPExporterConfig)
+		}
+	}
+
+	for _, exporter := range cfg.Spec.Exporters.NamedOTLPGRPCExporters {
+		if exporter.IsEnabled() {
+			id := config.ExporterID(config.ExporterNameOTLPGRPC, exporter.Name)
+			exporters[id] = a.getOTLPGRPCExporterConfig(id, exporter.OTLPGRPCExporterConfig)
+		}
+	}
+
+	return exporters
+}
+
+// exporterSecurityConfig provides the TLS and bearer token settings of an
+// enabled exporter, which require volumes to be mounted into the collector.
+type exporterSecurityConfig struct {
+	id    string
+	tls   *config.TLSConfig
+	token *config.ResourceReference
+}
+
+// getExporterSecurityConfigs returns the TLS and bearer token settings of the
+// enabled OTLP exporters.
+func getExporterSecurityConfigs(cfg config.CollectorConfig) []exporterSecurityConfig {
+	result := make([]exporterSecurityConfig, 0)
+	exporters := cfg.Spec.Exporters
+
+	if exporters.OTLPHTTPExporter.IsEnabled() {
+		result = append(result, exporterSecurityConfig{
+			id:    config.ExporterNameOTLPHTTP,
+			tls:   exporters.OTLPHTTPExporter.TLS,
+			token: exporters.OTLPHTTPExporter.Token,
+		})
+	}
+
+	if exporters.OTLPGRPCExporter.IsEnabled() {
+		result = append(result, exporterSecurityConfig{
+			id:    config.ExporterNameOTLPGRPC,
+			tls:   exporters.OTLPGRPCExporter.TLS,
+			token: exporters.OTLPGRPCExporter.Token,
+		})
+	}
+
+	if exporters.PrometheusRemoteWriteExporter.IsEnabled() {
+		result = append(result, exporterSecurityConfig{
+			id:    config.ExporterNamePrometheusRemoteWrite,
+			tls:   exporters.PrometheusRemoteWriteExporter.TLS,
+			token: exporters.PrometheusRemoteWriteExporter.Token,
+		})
+	}
+
+	if exporters.KafkaExporter.IsEnabled() {
+		result = append(result, exporterSecurityConfig{
+			id:  config.ExporterNameKafka,
+			tls: exporters.KafkaExporter.TLS,
+		})
+	}
+
+	for _, exporter := range exporters.NamedOTLPHTTPExporters {
+		if exporter.IsEnabled() {
+			result = append(result, exporterSecurityConfig{
+				id:    config.ExporterID(config.ExporterNameOTLPHTTP, exporter.Name),
+				tls:   exporter.TLS,
+				token: exporter.Token,
+			})
+		}
+	}
+
+	for _, exporter := range exporters.NamedOTLPGRPCExporters {
+		if exporter.IsEnabled() {
+			result = append(result, exporterSecurityConfig{
+				id:    config.ExporterID(config.ExporterNameOTLPGRPC, exporter.Name),
+				tls:   exporter.TLS,
+				token: exporter.Token,
+			})
+		}
+	}
+
+	return result
+}
+
+// exporterNameReplacer replaces the characters of exporter ids, which are not
+// allowed in volume names.
+var exporterNameReplacer = strings.NewReplacer("_", "-", "/", "-")
+
+// exporterResourceSuffix returns the suffix used for the names of the
+// extensions, volumes and mount paths of the exporter with the given id,
+// e.g. "exporter-otlp-http" for the "otlp_http" exporter.
+func exporterResourceSuffix(id string) string {
+	return "exporter-" + exporterNameReplacer.Replace(id)
+}
+
+// exporterBearerTokenAuthName returns the name of the bearertokenauth
+// extension for the exporter with the given id.
+func exporterBearerTokenAuthName(id string) string {
+	return baseBearerTokenAuthName + "/" + exporterResourceSuffix(id)
+}
+
+// exporterVolumeNameTLS returns the name of the TLS volume for the exporter
+// with the given id.
+func exporterVolumeNameTLS(id string) string {
+	return baseVolumeNameTLS + "-" + exporterResourceSuffix(id)
+}
+
+// exporterVolumeMountPathTLS returns the mount path of the TLS volume for the
+// exporter with the given id.
+func exporterVolumeMountPathTLS(id string) string {
+	return baseVolumeMountPathTLS + "-" + exporterResourceSuffix(id)
+}
+
+// exporterVolumeNameBearerToken returns the name of the bearer token volume
+// for the exporter with the given id.
+func exporterVolumeNameBearerToken(id string) string {
+	return baseVolumeNameBearerToken + "-" + exporterResourceSuffix(id)
+}
+
+// exporterVolumeMountPathBearerTokenFile returns the mount path of the bearer
+// token volume for the exporter with the given id.
+func exporterVolumeMountPathBearerTokenFile(id string) string {
+	return baseVolumeMountPathBearerTokenFile + "-" + exporterResourceSuffix(id)
+}
+
+// exporterVolumeNameWAL returns the name of the Write-Ahead Log volume for the
+// exporter with the given id.
+func exporterVolumeNameWAL(id string) string {
+	return baseVolumeNameWAL + "-" + exporterResourceSuffix(id)
+}
+
+// exporterVolumeMountPathWAL returns the mount path of the Write-Ahead Log
+// volume for the exporter with the given id.
+func exporterVolumeMountPathWAL(id string) string {
+	return baseVolumeMountPathWAL + "-" + exporterResourceSuffix(id)
+}
+
+// getExporterTLSConfig returns the OTel TLS client settings for the exporter
+// with the given id.
+func getExporterTLSConfig(id string, tls *config.TLSConfig) map[string]any {
+	tlsConfig := map[string]any{}
+	mountPath := exporterVolumeMountPathTLS(id)
+
+	if tls.InsecureSkipVerify != nil {
+		tlsConfig["insecure_skip_verify"] = *tls.InsecureSkipVerify
+	}
+	if tls.CA != nil {
+		tlsConfig["ca_file"] = filepath.Join(mountPath, tls.CA.ResourceRef.DataKey)
+	}
+	if tls.Cert != nil {
+		tlsConfig["cert_file"] = filepath.Join(mountPath, tls.Cert.ResourceRef.DataKey)
+	}
+	if tls.Key != nil {
+		tlsConfig["key_file"] = filepath.Join(mountPath, tls.Key.ResourceRef.DataKey)
+	}
+
+	tlsConfig["reload_interval"] = tls.ReloadInterval.String()
+
+	return tlsConfig
+}
+
+// parseShootNamespaceAttributes extracts OTel resource attributes from a shoot
+// namespace name of the form "shoot--<project>--<shoot>".
+// The full namespace name maps to k8s.cluster.name; the two segments map to
+// gardener.project.name and gardener.shoot.name respectively.
+// For namespaces that do not follow the pattern, projectName and shootName are
+// returned as empty strings.
+func parseShootNamespaceAttributes(namespace string) (clusterName, projectName, shootName string) {
+	clusterName = namespace
+	parts := strings.SplitN(namespace, "--", 3)
+	if len(parts) == 3 {
+		projectName = parts[1]
+		shootName = parts[2]
+	}
+
+	return clusterName, projectName, shootName
+}
+
+// getResourceAttributes returns the attributes, which the resource processor
+// upserts into the telemetry data. The attributes parsed from the namespace
+// are complemented by attributes derived from the [extensionscontroller.Cluster],
+// the allowlisted shoot labels and annotations, and the static attributes.
+func getResourceAttributes(
+	namespace string,
+	cluster *extensionscontroller.Cluster,
+	cfg config.ResourceAttributesConfig,
+) []any {
+	clusterName, projectName, shootName := parseShootNamespaceAttributes(namespace)
+	attributes := []any{
+		upsertAttribute("k8s.cluster.name", clusterName),
+		upsertAttribute("gardener.project.name", projectName),
+		upsertAttribute("gardener.shoot.name", shootName),
+	}
+
+	if cluster != nil && cluster.Seed != nil {
+		attributes = append(attributes, upsertAttribute("gardener.seed.name", cluster.Seed.Name))
+	}
+
+	if cluster != nil && cluster.Shoot != nil {
+		shoot := cluster.Shoot
+		attributes = append(
+			attributes,
+			upsertAttribute("gardener.shoot.uid", string(shoot.UID)),
+			upsertAttribute("gardener.shoot.region", shoot.Spec.Region),
+			upsertAttribute("gardener.shoot.provider.type", shoot.Spec.Provider.Type),
+			upsertAttribute("gardener.shoot.kubernetes.version", shoot.Spec.Kubernetes.Version),
+		)
+
+		for _, key := range cfg.ShootLabels {
+			if value, ok := shoot.Labels[key]; ok {
+				attributes = append(attributes, upsertAttribute("gardener.shoot.label."+key, value))
+			}
+		}
+
+		for _, key := range cfg.ShootAnnotations {
+			if value, ok := shoot.Annotations[key]; ok {
+				attributes = append(attributes, upsertAttribute("gardener.shoot.annotation."+key, value))
+			}
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(cfg.Static)) {
+		attributes = append(attributes, upsertAttribute(key, cfg.Static[key]))
+	}
+
+	return attributes
+}
+
+// getPipelineExporters returns the exporters, which receive the signals of a
+// pipeline. When the pipeline does not specify any exporters, the signals are
+// sent to all enabled exporters, which support the signal of the pipeline.
+func getPipelineExporters(cfg config.CollectorConfig, signal config.Signal, exporters []string) []string {
+	if len(exporters) == 0 {
+		return cfg.Spec.Exporters.EnabledExporterNamesForSignal(signal)
+	}
+
+	return slices.Sorted(slices.Values(exporters))
+}
+
+// getPipelineProcessors returns the processors of a pipeline. The given
+// processors are placed after the memory_limiter processor, so that memory is
+// protected before they process any data, and before the batch processor, so
+// that only the remaining data is batched.
+func getPipelineProcessors(processors ...string) []string {
+	return slices.Concat(
+		[]string{resourceProcessorName, memoryLimiterProcessorName},
+		processors,
+		[]string{batchProcessorName},
+	)
+}
+
+// getLogsProcessors returns the processors of the logs pipeline.
+func getLogsProcessors(cfg config.CollectorConfig) []string {
+	processors := make([]string, 0)
+
+	if cfg.Spec.Filters.Logs.IsEnabled() {
+		processors = append(processors, filterLogsProcessorName)
+	}
+
+	return getPipelineProcessors(processors...)
+}
+
+// getEventsProcessors returns the processors of the events pipeline. The
+// events are filtered before they are transformed, so that dropped events are
+// not processed any further.
+func getEventsProcessors(cfg config.CollectorConfig) []string {
+	processors := make([]string, 0)
+
+	if cfg.Spec.Events.IsFiltered() {
+		processors = append(processors, filterEventsProcessorName)
+	}
+
+	processors = append(processors, transformEventsProcessorName)
+
+	return getPipelineProcessors(processors...)
+}
+
+// getMetricsProcessors returns the processors of the metrics pipeline.
+func getMetricsProcessors(cfg config.CollectorConfig) []string {
+	processors := make([]string, 0)
+
+	if cfg.Spec.Filters.Metrics.IsEnabled() {
+		processors = append(processors, filterMetricsProcessorName)
+	}
+
+	return getPipelineProcessors(processors...)
+}
+
+// getTracesProcessors returns the processors of the traces pipeline. Spans
+// are filtered before the sampling decision, so that dropped spans are not
+// buffered by the tail sampling.
+func getTracesProcessors(cfg config.CollectorConfig) []string {
+	processors := make([]string, 0)
+
+	if cfg.Spec.Filters.Traces.IsEnabled() {
+		processors = append(processors, filterTracesProcessorName)
+	}
+
+	if cfg.Spec.Sampling.Probabilistic.IsEnabled() {
+		processors = append(processors, probabilisticSamplerProcessorName)
+	}
+
+	if cfg.Spec.Sampling.TailSampling.IsEnabled() {
+		processors = append(processors, tailSamplingProcessorName)
+	}
+
+	return getPipelineProcessors(processors...)
+}
+
+// getFilterProcessors returns the settings of the filter processors for the
+// pipelines with conditions.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/filterprocessor
+func getFilterProcessors(cfg config.FiltersConfig) map[string]any {
+	processors := make(map[string]any)
+
+	if cfg.Logs.IsEnabled() {
+		processors[filterLogsProcessorName] = map[string]any{
+			"error_mode": string(cfg.ErrorMode),
+			"logs": map[string]any{
+				"log_record": cfg.Logs.LogRecord,
+			},
+		}
+	}
+
+	if cfg.Metrics.IsEnabled() {
+		metrics := make(map[string]any)
+		if len(cfg.Metrics.Metric) > 0 {
+			metrics["metric"] = cfg.Metrics.Metric
+		}
+		if len(cfg.Metrics.DataPoint) > 0 {
+			metrics["datapoint"] = cfg.Metrics.DataPoint
+		}
+		processors[filterMetricsProcessorName] = map[string]any{
+			"error_mode": string(cfg.ErrorMode),
+			"metrics":    metrics,
+		}
+	}
+
+	if cfg.Traces.IsEnabled() {
+		traces := make(map[string]any)
+		if len(cfg.Traces.Span) > 0 {
+			traces["span"] = cfg.Traces.Span
+		}
+		if len(cfg.Traces.SpanEvent) > 0 {
+			traces["spanevent"] = cfg.Traces.SpanEvent
+		}
+		processors[filterTracesProcessorName] = map[string]any{
+			"error_mode": string(cfg.ErrorMode),
+			"traces":     traces,
+		}
+	}
+
+	return processors
+}
+
+// getEventsFilterProcessors returns the settings of the filter processor for
+// the events pipeline, when any of the events are filtered.
+//
+// The k8sobjects receiver emits the watched objects wrapped into the
+// `object` field of the log body, while the pulled objects are emitted as the
+// log body itself. Hence, each condition is rendered for both layouts, and
+// only matches objects of the Event kind, so that other collected objects
+// are kept.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/filterprocessor
+func getEventsFilterProcessors(cfg config.EventsConfig) map[string]any {
+	processors := make(map[string]any)
+
+	if !cfg.IsFiltered() {
+		return processors
+	}
+
+	types := make([]string, 0, len(cfg.Types))
+	for _, eventType := range cfg.Types {
+		types = append(types, string(eventType))
+	}
+
+	conditions := make([]string, 0)
+	for _, object := range []string{`body["object"]`, "body"} {
+		matches := func(field string, values []string) string {
+			quoted := make([]string, 0, len(values))
+			for _, value := range values {
+				quoted = append(quoted, regexp.QuoteMeta(value))
+			}
+
+			pattern := "^(" + strings.Join(quoted, "|") + ")$"
+
+			return fmt.Sprintf(`IsMatch(%s%s, %q)`, object, field, pattern)
+		}
+
+		isEvent := fmt.Sprintf(`%s["kind"] == "Event"`, object)
+		if len(cfg.ExcludeNamespaces) > 0 {
+			conditions = append(conditions, isEvent+" and "+matches(`["metadata"]["namespace"]`, cfg.ExcludeNamespaces))
+		}
+		if len(types) > 0 {
+			conditions = append(conditions, isEvent+" and not "+matches(`["type"]`, types))
+		}
+		if len(cfg.Reasons) > 0 {
+			conditions = append(conditions, isEvent+" and not "+matches(`["reason"]`, cfg.Reasons))
+		}
+		if len(cfg.ExcludeReasons) > 0 {
+			conditions = append(conditions, isEvent+" and "+matches(`["reason"]`, cfg.ExcludeReasons))
+		}
+	}
+
+	processors[filterEventsProcessorName] = map[string]any{
+		"error_mode": string(config.FilterErrorModeIgnore),
+		"logs": map[string]any{
+			"log_record": conditions,
+		},
+	}
+
+	return processors
+}
+
+// getSamplingProcessors returns the settings of the enabled sampling
+// processors of the traces pipeline.
+func getSamplingProcessors(cfg config.SamplingConfig) map[string]any {
+	processors := make(map[string]any)
+
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/probabilisticsamplerprocessor
+	if cfg.Probabilistic.IsEnabled() {
+		processors[probabilisticSamplerProcessorName] = map[string]any{
+			"sampling_percentage": cfg.Probabilistic.SamplingPercentage,
+		}
+	}
+
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/tailsamplingprocessor
+	if cfg.TailSampling.IsEnabled() {
+		policies := make([]any, 0, len(cfg.TailSampling.Policies))
+		for _, policy := range cfg.TailSampling.Policies {
+			policies = append(policies, getTailSamplingPolicyConfig(policy))
+		}
+
+		processors[tailSamplingProcessorName] = map[string]any{
+			"decision_wait": cfg.TailSampling.DecisionWait.String(),
+			"num_traces":    cfg.TailSampling.NumTraces,
+			"policies":      policies,
+		}
+	}
+
+	return processors
+}
+
+// getTailSamplingPolicyConfig returns the settings of a tail sampling policy.
+func getTailSamplingPolicyConfig(policy config.TailSamplingPolicy) map[string]any {
+	result := map[string]any{
+		"name": policy.Name,
+		"type": string(policy.Type),
+	}
+
+	switch policy.Type {
+	case config.TailSamplingPolicyTypeLatency:
+		if policy.Latency != nil {
+			latency := map[string]any{
+				"threshold_ms": policy.Latency.ThresholdMs,
+			}
+			if policy.Latency.UpperThresholdMs > 0 {
+				latency["upper_threshold_ms"] = policy.Latency.UpperThresholdMs
+			}
+			result["latency"] = latency
+		}
+	case config.TailSamplingPolicyTypeStatusCode:
+		if policy.StatusCode != nil {
+			result["status_code"] = map[string]any{
+				"status_codes": policy.StatusCode.StatusCodes,
+			}
+		}
+	case config.TailSamplingPolicyTypeStringAttribute:
+		if policy.StringAttribute != nil {
+			result["string_attribute"] = map[string]any{
+				"key":                    policy.StringAttribute.Key,
+				"values":                 policy.StringAttribute.Values,
+				"enabled_regex_matching": policy.StringAttribute.EnabledRegexMatching,
+				"invert_match":           policy.StringAttribute.InvertMatch,
+			}
+		}
+	}
+
+	return result
+}
+
+// getOtelPipelines returns the enabled pipelines of the collector along with
+// the exporters, which receive the signals of each pipeline.
+func getOtelPipelines(cfg config.CollectorConfig) map[string]*otelv1beta1.Pipeline {
+	pipelines := make(map[string]*otelv1beta1.Pipeline)
+
+	if cfg.Spec.Pipelines.Logs.IsEnabled() {
+		pipelines["logs"] = &otelv1beta1.Pipeline{
+			Receivers:  []string{"otlp"},
+			Processors: getLogsProcessors(cfg),
+			Exporters:  getPipelineExporters(cfg, config.SignalLogs, cfg.Spec.Pipelines.Logs.Exporters),
+		}
+	}
+
+	if cfg.Spec.Pipelines.Events.IsEnabled() {
+		pipelines["logs/events"] = &otelv1beta1.Pipeline{
+			Receivers:  []string{"k8sobjects/events"},
+			Processors: getEventsProcessors(cfg),
+			Exporters:  getPipelineExporters(cfg, config.SignalLogs, cfg.Spec.Pipelines.Events.Exporters),
+		}
+	}
+
+	if cfg.Spec.Pipelines.Metrics.IsEnabled() {
+		pipelines["metrics"] = &otelv1beta1.Pipeline{
+			Receivers:  []string{"prometheus"},
+			Processors: getMetricsProcessors(cfg),
+			Exporters:  getPipelineExporters(cfg, config.SignalMetrics, cfg.Spec.Pipelines.Metrics.Exporters),
+		}
+	}
+
+	if cfg.Spec.Pipelines.Traces.IsEnabled() {
+		pipelines["traces"] = &otelv1beta1.Pipeline{
+			Receivers:  []string{"otlp"},
+			Processors: getTracesProcessors(cfg),
+			Exporters:  getPipelineExporters(cfg, config.SignalTraces, cfg.Spec.Pipelines.Traces.Exporters),
+		}
+	}
+
+	return pipelines
+}
+
+// getOTelCollector returns the [otelv1beta1.OpenTelemetryCollector]
+// resource, which the extension manages.
+func (a *Actuator) getOtelCollector(
+	namespace string,
+	caSecret, clientSecret *corev1.Secret,
+	cfg config.CollectorConfig,
+	cluster *extensionscontroller.Cluster,
+	shootKubeconfigSecretName string,
 	accessSecretName string,
 	image *imagevectorutils.Image,
 ) *otelv1beta1.OpenTelemetryCollector {
@@ -1101,19 +2102,21 @@ func (a *Actuator) getOtelCollector(
 
 		volumeNameClientCertificate      = "client-cert"
 		volumeMountPathClientCertificate = "/etc/ssl/certs/client"
-
-		baseVolumeNameBearerToken         = "bearer-token-auth"                               // #nosec: G101
-		httpExporterVolumeNameBearerToken = baseVolumeNameBearerToken + "-exporter-otlp-http" // #nosec: G101
-		grpcExporterVolumeNameBearerToken = baseVolumeNameBearerToken + "-exporter-otlp-grpc" // #nosec: G101
-
-		baseVolumeMountPathBearerTokenFile         = "/etc/auth/bearer"                                         // #nosec: G101
-		httpExporterVolumeMountPathBearerTokenFile = baseVolumeMountPathBearerTokenFile + "-exporter-otlp-http" // #nosec: G101
-		grpcExporterVolumeMountPathBearerTokenFile = baseVolumeMountPathBearerTokenFile + "-exporter-otlp-grpc" // #nosec: G101
 	)
 
 	exporters := a.getOtelExporters(cfg)
-	exporterNames := slices.Sorted(maps.Keys(exporters))
-	clusterName, projectName, shootName := parseShootNamespaceAttributes(namespace)
+	resources := cluster.Shoot.Spec.Resources
+
+	// The scrape jobs handed out by the Target Allocator carry the metric
+	// relabeling rules via the default scrape class, the static job of the
+	// receiver gets them directly.
+	scrapeJob := map[string]any{
+		"job_name":        otelCollectorName,
+		"scrape_interval": "15s",
+	}
+	if relabelConfigs := cfg.Spec.Metrics.Scrape.MetricRelabelConfigs; len(relabelConfigs) > 0 {
+		scrapeJob["metric_relabel_configs"] = getRelabelConfigs(relabelConfigs)
+	}
 	allLabels := utils.MergeStringMaps(
 		a.getCommonLabels(),
 		a.getNetworkLabels(),
@@ -1145,7 +2148,7 @@ func (a *Actuator) getOtelCollector(
 			UpgradeStrategy: otelv1beta1.UpgradeStrategyNone,
 			OpenTelemetryCommonFields: otelv1beta1.OpenTelemetryCommonFields{
 				Image:    image.String(),
-				Replicas: new(otelCollectorReplicas),
+				Replicas: new(getReplicas(cfg.Spec.Scaling.Collector.Replicas, defaultOtelCollectorReplicas)),
 				VolumeMounts: []corev1.VolumeMount{
 					{Name: volumeNameCACertificate, MountPath: volumeMountPathCACertificate, ReadOnly: true},
 					{Name: volumeNameClientCertificate, MountPath: volumeMountPathClientCertificate, ReadOnly: true},
@@ -1161,12 +2164,7 @@ func (a *Actuator) getOtelCollector(
 					Value: gardenerutils.PathGenericKubeconfig,
 				}},
 				PriorityClassName: v1beta1constants.PriorityClassNameShootControlPlane100,
-				Resources: corev1.ResourceRequirements{
-					Requests: corev1.ResourceList{
-						corev1.ResourceCPU:    resource.MustParse("10m"),
-						corev1.ResourceMemory: resource.MustParse("50Mi"),
-					},
-				},
+				Resources:         getResourceRequirements(cfg.Spec.Scaling.Collector.Resources),
 				SecurityContext: &corev1.SecurityContext{
 					AllowPrivilegeEscalation: new(false),
 				},
@@ -1196,23 +2194,12 @@ func (a *Actuator) getOtelCollector(
 								},
 							},
 							"config": map[string]any{
-								"scrape_configs": []any{
-									map[string]any{
-										"job_name":        otelCollectorName,
-										"scrape_interval": "15s",
-									},
-								},
+								"scrape_configs": []any{scrapeJob},
 							},
 						},
 						"k8sobjects/events": map[string]any{
 							"auth_type": "kubeConfig",
-							"objects": []any{
-								map[string]any{
-									"name":  "events",
-									"group": "events.k8s.io",
-									"mode":  "watch",
-								},
-							},
+							"objects":   getKubernetesObjectsReceiverConfig(getKubernetesObjects(cfg), cfg.Spec.Events),
 						},
 					},
 				},
@@ -1231,18 +2218,15 @@ func (a *Actuator) getOtelCollector(
 							"spike_limit_percentage": a.memoryLimiterConfig.MemorySpikePercentage,
 						},
 						resourceProcessorName: map[string]any{
-							"attributes": []any{
-								upsertAttribute("k8s.cluster.name", clusterName),
-								upsertAttribute("gardener.project.name", projectName),
-								upsertAttribute("gardener.shoot.name", shootName),
-							},
+							"attributes": getResourceAttributes(namespace, cluster, cfg.Spec.ResourceAttributes),
 						},
 						transformEventsProcessorName: map[string]any{
 							"log_statements": []any{
 								map[string]any{
 									"context": "log",
 									"statements": []any{
-										`delete_key(body["object"]["metadata"], "managedFields")`,
+										`delete_key(body["object"]["metadata"], "managedFields") where body["object"] != nil`,
+										`delete_key(body["metadata"], "managedFields") where body["metadata"] != nil`,
 									},
 								},
 							},
@@ -1263,7 +2247,7 @@ func (a *Actuator) getOtelCollector(
 											"exporter": map[string]any{
 												configKeyPrometheus: map[string]any{
 													"host": "0.0.0.0",
-													"port": otelCollectorMetricsPort,
+													"port": CollectorMetricsPort,
 												},
 											},
 										},
@@ -1276,93 +2260,198 @@ func (a *Actuator) getOtelCollector(
 							},
 						},
 					},
-					Pipelines: map[string]*otelv1beta1.Pipeline{
-						"logs": {
-							Receivers:  []string{"otlp"},
-							Processors: []string{resourceProcessorName, memoryLimiterProcessorName, batchProcessorName},
-							Exporters:  exporterNames,
-						},
-						"logs/events": {
-							Receivers:  []string{"k8sobjects/events"},
-							Processors: []string{resourceProcessorName, memoryLimiterProcessorName, transformEventsProcessorName, batchProcessorName},
-							Exporters:  exporterNames,
-						},
-						"metrics": {
-							Receivers:  []string{"prometheus"},
-							Processors: []string{resourceProcessorName, memoryLimiterProcessorName, batchProcessorName},
-							Exporters:  exporterNames,
-						},
-					},
+					Pipelines: getOtelPipelines(cfg),
 				},
 			},
 		},
 	}
 
-	// OTLP HTTP exporter TLS settings
-	a.configureVolumeForTLS(
-		obj,
-		cfg.Spec.Exporters.OTLPHTTPExporter.TLS,
-		httpExporterVolumeNameTLS,
-		httpExporterVolumeMountPathTLS,
-		resources,
-	)
-
-	// OTLP HTTP exporter Bearer Token Authentication settings
+	// TLS and Bearer Token Authentication settings of the exporters
 	//
 	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/extension/bearertokenauthextension
-	a.configureVolumeForBearerTokenAuthExtension(
-		obj,
-		cfg.Spec.Exporters.OTLPHTTPExporter.Token,
-		httpExporterBearerTokenAuthName,
-		httpExporterVolumeMountPathBearerTokenFile,
-		httpExporterVolumeNameBearerToken,
-		httpExporterVolumeMountPathBearerTokenFile,
-		resources,
-	)
+	for _, exporter := range getExporterSecurityConfigs(cfg) {
+		a.configureVolumeForTLS(
+			obj,
+			exporter.tls,
+			exporterVolumeNameTLS(exporter.id),
+			exporterVolumeMountPathTLS(exporter.id),
+			resources,
+		)
 
-	// OTLP gRPC exporter TLS settings
-	a.configureVolumeForTLS(
-		obj,
-		cfg.Spec.Exporters.OTLPGRPCExporter.TLS,
-		grpcExporterVolumeNameTLS,
-		grpcExporterVolumeMountPathTLS,
-		resources,
-	)
+		a.configureVolumeForBearerTokenAuthExtension(
+			obj,
+			exporter.token,
+			exporterBearerTokenAuthName(exporter.id),
+			exporterVolumeMountPathBearerTokenFile(exporter.id),
+			exporterVolumeNameBearerToken(exporter.id),
+			exporterVolumeMountPathBearerTokenFile(exporter.id),
+			resources,
+		)
+	}
 
-	// OTLP gRPC exporter Bearer Token Authentication settings
-	a.configureVolumeForBearerTokenAuthExtension(
-		obj,
-		cfg.Spec.Exporters.OTLPGRPCExporter.Token,
-		grpcExporterBearerTokenAuthName,
-		grpcExporterVolumeMountPathBearerTokenFile,
-		grpcExporterVolumeNameBearerToken,
-		grpcExporterVolumeMountPathBearerTokenFile,
-		resources,
-	)
+	// Autoscaling and disruption budget of the collector
+	a.configureScaling(obj, cfg.Spec.Scaling.Collector)
+
+	// Persistent sending queues
+	//
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/extension/storage/filestorage
+	if usesFileStorage(cfg) {
+		a.configureFileStorage(obj, cfg.Spec.Storage)
+	}
+
+	// Filter processors of the pipelines
+	maps.Copy(obj.Spec.Config.Processors.Object, getFilterProcessors(cfg.Spec.Filters))
+
+	// Filter processor of the events pipeline
+	maps.Copy(obj.Spec.Config.Processors.Object, getEventsFilterProcessors(cfg.Spec.Events))
+
+	// Sampling processors of the traces pipeline
+	maps.Copy(obj.Spec.Config.Processors.Object, getSamplingProcessors(cfg.Spec.Sampling))
+
+	// Kafka exporter SASL Authentication settings
+	if kafka := cfg.Spec.Exporters.KafkaExporter; kafka.IsEnabled() && kafka.SASL != nil {
+		a.configureEnvFromSecret(obj, envVarKafkaSASLUsername, kafka.SASL.Username, resources)
+		a.configureEnvFromSecret(obj, envVarKafkaSASLPassword, kafka.SASL.Password, resources)
+	}
+
+	// Prometheus Remote Write exporter Write-Ahead Log settings
+	if prw := cfg.Spec.Exporters.PrometheusRemoteWriteExporter; prw.IsEnabled() && prw.WAL.IsEnabled() {
+		a.configureVolumeForWAL(
+			obj,
+			exporterVolumeNameWAL(config.ExporterNamePrometheusRemoteWrite),
+			exporterVolumeMountPathWAL(config.ExporterNamePrometheusRemoteWrite),
+		)
+	}
 
 	return obj
 }
 
-// getEventsClusterRole returns the [rbacv1.ClusterRole] granting the OTel
-// Collector's service account in the shoot cluster permission to list and watch
-// events from the events.k8s.io API group.
-func (a *Actuator) getEventsClusterRole() *rbacv1.ClusterRole {
+// getKubernetesObjects returns the Kubernetes objects, which are collected
+// from the shoot cluster. When none are configured, the events of the
+// events.k8s.io API group are watched. The events are collected from the
+// namespaces specified in the events settings, if any.
+func getKubernetesObjects(cfg config.CollectorConfig) []config.KubernetesObjectConfig {
+	objects := []config.KubernetesObjectConfig{{
+		Name:  "events",
+		Group: "events.k8s.io",
+		Mode:  config.KubernetesObjectModeWatch,
+	}}
+
+	if len(cfg.Spec.KubernetesObjects) > 0 {
+		objects = slices.Clone(cfg.Spec.KubernetesObjects)
+	}
+
+	// The validation ensures, that the namespaces of the events are not
+	// specified in both places.
+	if namespaces := cfg.Spec.Events.Namespaces; len(namespaces) > 0 {
+		for i := range objects {
+			if objects[i].IsEvents() && len(objects[i].Namespaces) == 0 {
+				objects[i].Namespaces = namespaces
+			}
+		}
+	}
+
+	return objects
+}
+
+// getKubernetesObjectsReceiverConfig returns the `objects` settings of the
+// k8sobjects receiver for the given Kubernetes objects.
+//
+// When only new events are collected, the updates and deletions of the
+// watched events are excluded. As Kubernetes aggregates the repeated
+// occurrences of an event by updating its count, their recurrences are
+// excluded as well.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/k8sobjectsreceiver
+func getKubernetesObjectsReceiverConfig(objects []config.KubernetesObjectConfig, events config.EventsConfig) []any {
+	result := make([]any, 0, len(objects))
+	for _, object := range objects {
+		mode := cmp.Or(object.Mode, config.KubernetesObjectModePull)
+		item := map[string]any{
+			"name": object.Name,
+			"mode": string(mode),
+		}
+
+		if object.Group != "" {
+			item["group"] = object.Group
+		}
+		if mode == config.KubernetesObjectModePull {
+			item["interval"] = cmp.Or(object.Interval, defaultKubernetesObjectInterval).String()
+		}
+		if len(object.Namespaces) > 0 {
+			item["namespaces"] = object.Namespaces
+		}
+		if object.LabelSelector != "" {
+			item["label_selector"] = object.LabelSelector
+		}
+		if object.FieldSelector != "" {
+			item["field_selector"] = object.FieldSelector
+		}
+		if events.IsOnlyNewEvents() && object.IsEvents() && mode == config.KubernetesObjectModeWatch {
+			item["exclude_watch_type"] = []string{"MODIFIED", "DELETED"}
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// getKubernetesObjectsPolicyRules returns the [rbacv1.PolicyRule] items
+// required by the k8sobjects receiver for collecting the given Kubernetes
+// objects. The rules for objects, which are collected from all namespaces,
+// are returned separately from the rules for objects, which are collected
+// from specific namespaces, keyed by namespace.
+func getKubernetesObjectsPolicyRules(objects []config.KubernetesObjectConfig) ([]rbacv1.PolicyRule, map[string][]rbacv1.PolicyRule) {
+	var (
+		clusterRules    []rbacv1.PolicyRule
+		namespacedRules = make(map[string][]rbacv1.PolicyRule)
+	)
+
+	for _, object := range objects {
+		// In watch mode the objects are listed as well, for determining the
+		// resource version, from which to watch.
+		verbs := []string{"list"}
+		if object.Mode == config.KubernetesObjectModeWatch {
+			verbs = []string{"list", "watch"}
+		}
+
+		rule := rbacv1.PolicyRule{
+			APIGroups: []string{object.Group},
+			Resources: []string{object.Name},
+			Verbs:     verbs,
+		}
+
+		if len(object.Namespaces) == 0 {
+			clusterRules = append(clusterRules, rule)
+			continue
+		}
+
+		for _, namespace := range object.Namespaces {
+			namespacedRules[namespace] = append(namespacedRules[namespace], rule)
+		}
+	}
+
+	return clusterRules, namespacedRules
+}
+
+// getKubernetesObjectsClusterRole returns the [rbacv1.ClusterRole] granting
+// the OTel Collector's service account in the shoot cluster permission to
+// collect the Kubernetes objects from all namespaces.
+func (a *Actuator) getKubernetesObjectsClusterRole(rules []rbacv1.PolicyRule) *rbacv1.ClusterRole {
 	return &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: otelCollectorName,
 		},
-		Rules: []rbacv1.PolicyRule{{
-			APIGroups: []string{"events.k8s.io"},
-			Resources: []string{"events"},
-			Verbs:     readVerbs,
-		}},
+		Rules: rules,
 	}
 }
 
-// getEventsClusterRoleBinding returns the [rbacv1.ClusterRoleBinding] that
-// binds the events ClusterRole to the OTel Collector's service account in the
-// shoot cluster's kube-system namespace.
-func (a *Actuator) getEventsClusterRoleBinding(serviceAccountName string) *rbacv1.ClusterRoleBinding {
+// getKubernetesObjectsClusterRoleBinding returns the
+// [rbacv1.ClusterRoleBinding] that binds the Kubernetes objects ClusterRole
+// to the OTel Collector's service account in the shoot cluster's kube-system
+// namespace.
+func (a *Actuator) getKubernetesObjectsClusterRoleBinding(serviceAccountName string) *rbacv1.ClusterRoleBinding {
 	return &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: otelCollectorName,
@@ -1380,6 +2469,123 @@ func (a *Actuator) getEventsClusterRoleBinding(serviceAccountName string) *rbacv
 	}
 }
 
+// getKubernetesObjectsRole returns the [rbacv1.Role] granting the OTel
+// Collector's service account in the shoot cluster permission to collect the
+// Kubernetes objects from the given namespace.
+func (a *Actuator) getKubernetesObjectsRole(namespace string, rules []rbacv1.PolicyRule) *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      otelCollectorName,
+			Namespace: namespace,
+		},
+		Rules: rules,
+	}
+}
+
+// getKubernetesObjectsRoleBinding returns the [rbacv1.RoleBinding] that binds
+// the Kubernetes objects Role in the given namespace to the OTel Collector's
+// service account in the shoot cluster's kube-system namespace.
+func (a *Actuator) getKubernetesObjectsRoleBinding(namespace, serviceAccountName string) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      otelCollectorName,
+			Namespace: namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     otelCollectorName,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccountName,
+			Namespace: metav1.NamespaceSystem,
+		}},
+	}
+}
+
+// getEventsLeaseRole returns the [rbacv1.Role] granting the OTel Collector's
+// service account in the shoot cluster permission to manage the Lease used
+// for electing the replica, which watches the events.
+func (a *Actuator) getEventsLeaseRole() *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      eventsLeaseName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{coordinationv1.GroupName},
+				Resources: []string{"leases"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups:     []string{coordinationv1.GroupName},
+				Resources:     []string{"leases"},
+				ResourceNames: []string{eventsLeaseName},
+				Verbs:         []string{"get", "update"},
+			},
+		},
+	}
+}
+
+// getEventsLeaseRoleBinding returns the [rbacv1.RoleBinding] that binds the
+// events Lease Role to the OTel Collector's service account in the shoot
+// cluster's kube-system namespace.
+func (a *Actuator) getEventsLeaseRoleBinding(serviceAccountName string) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      eventsLeaseName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     eventsLeaseName,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccountName,
+			Namespace: metav1.NamespaceSystem,
+		}},
+	}
+}
+
+// getReplicas returns the given number of replicas, or the given default, if
+// none is specified.
+func getReplicas(replicas *int32, defaultReplicas int32) int32 {
+	if replicas == nil {
+		return defaultReplicas
+	}
+
+	return *replicas
+}
+
+// getResourceRequirements returns the given compute resources, or the default
+// requests of 10m CPU and 50Mi memory, if none are specified.
+func getResourceRequirements(resources *corev1.ResourceRequirements) corev1.ResourceRequirements {
+	if resources != nil {
+		return *resources.DeepCopy()
+	}
+
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("50Mi"),
+		},
+	}
+}
+
+// runsMultipleReplicas returns true, if the OTel Collector may run with more
+// than one replica for the given scaling settings.
+func runsMultipleReplicas(cfg config.CollectorScalingConfig) bool {
+	if cfg.Autoscaling != nil {
+		return cfg.Autoscaling.MaxReplicas > 1
+	}
+
+	return getReplicas(cfg.Replicas, defaultOtelCollectorReplicas) > 1
+}
+
 func secretNameForResource(resourceName string, resources []gardencorev1beta1.NamedResourceReference) string {
 	for _, r := range resources {
 		if r.Name == resourceName &&
@@ -1391,6 +2597,59 @@ func secretNameForResource(resourceName string, resources []gardencorev1beta1.Na
 	return ""
 }
 
+// getReferencedResourceNames returns the sorted names of the resources, which
+// are referenced by the enabled exporters.
+func getReferencedResourceNames(cfg config.CollectorConfig) []string {
+	refs := make([]*config.ResourceReference, 0)
+	for _, exporter := range getExporterSecurityConfigs(cfg) {
+		refs = append(refs, exporter.token)
+		if exporter.tls != nil {
+			refs = append(refs, exporter.tls.CA, exporter.tls.Cert, exporter.tls.Key)
+		}
+	}
+
+	if kafka := cfg.Spec.Exporters.KafkaExporter; kafka.IsEnabled() && kafka.SASL != nil {
+		refs = append(refs, kafka.SASL.Username, kafka.SASL.Password)
+	}
+
+	names := make([]string, 0)
+	for _, ref := range refs {
+		if ref != nil && !slices.Contains(names, ref.ResourceRef.Name) {
+			names = append(names, ref.ResourceRef.Name)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// getReferencedSecretChecksums returns the checksums of the referenced secrets
+// as pod annotations for the collector, so that the collector is rolled out,
+// when the data of any referenced secret changes.
+func (a *Actuator) getReferencedSecretChecksums(
+	ctx context.Context,
+	namespace string,
+	cfg config.CollectorConfig,
+	resources []gardencorev1beta1.NamedResourceReference,
+) (map[string]string, error) {
+	checksums := make(map[string]string)
+	for _, name := range getReferencedResourceNames(cfg) {
+		secretName := secretNameForResource(name, resources)
+		if secretName == "" {
+			return nil, fmt.Errorf("referenced resource %q is not a secret in the shoot resources", name)
+		}
+
+		secret := &corev1.Secret{}
+		if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretName}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get referenced secret %s: %w", secretName, err)
+		}
+
+		checksums[annotationKeyChecksumSecretPrefix+secretName] = utils.ComputeSecretChecksum(secret.Data)
+	}
+
+	return checksums, nil
+}
+
 // configureVolumeForTLS configures a volume for the OpenTelemetry collector for
 // TLS secrets.
 func (a *Actuator) configureVolumeForTLS(
@@ -1445,6 +2704,199 @@ func (a *Actuator) configureVolumeForTLS(
 	)
 }
 
+// configureScaling configures the horizontal pod autoscaler and the
+// PodDisruptionBudget of the OpenTelemetry collector.
+//
+// When the collector may run with more than one replica, the scrape targets
+// are distributed among the replicas by the Target Allocator, while the
+// k8sobjects/events receiver only runs in the replica holding the Lease of the
+// k8s_leader_elector extension, so that the events are not collected multiple
+// times.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/extension/k8sleaderelector
+func (a *Actuator) configureScaling(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	cfg config.CollectorScalingConfig,
+) {
+	if obj == nil {
+		return
+	}
+
+	if autoscaling := cfg.Autoscaling; autoscaling != nil {
+		minReplicas := getReplicas(autoscaling.MinReplicas, defaultOtelCollectorReplicas)
+		autoscaler := &otelv1beta1.AutoscalerSpec{
+			MinReplicas:             new(minReplicas),
+			MaxReplicas:             new(autoscaling.MaxReplicas),
+			TargetCPUUtilization:    autoscaling.TargetCPUUtilization,
+			TargetMemoryUtilization: autoscaling.TargetMemoryUtilization,
+		}
+
+		if autoscaler.TargetCPUUtilization == nil && autoscaler.TargetMemoryUtilization == nil {
+			autoscaler.TargetCPUUtilization = new(defaultAutoscalerTargetCPUUtilization)
+		}
+
+		obj.Spec.Autoscaler = autoscaler
+		obj.Spec.Replicas = new(minReplicas)
+	}
+
+	if !runsMultipleReplicas(cfg) {
+		return
+	}
+
+	obj.Spec.PodDisruptionBudget = &otelv1beta1.PodDisruptionBudgetSpec{
+		MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+	}
+
+	if obj.Spec.Config.Extensions == nil {
+		obj.Spec.Config.Extensions = &otelv1beta1.AnyConfig{}
+	}
+
+	if obj.Spec.Config.Extensions.Object == nil {
+		obj.Spec.Config.Extensions.Object = make(map[string]any)
+	}
+
+	obj.Spec.Config.Extensions.Object[leaderElectorExtensionName] = map[string]any{
+		"auth_type":       "kubeConfig",
+		"lease_name":      eventsLeaseName,
+		"lease_namespace": metav1.NamespaceSystem,
+	}
+
+	obj.Spec.Config.Service.Extensions = append(obj.Spec.Config.Service.Extensions, leaderElectorExtensionName)
+
+	if receiver, ok := obj.Spec.Config.Receivers.Object["k8sobjects/events"].(map[string]any); ok {
+		receiver["k8s_leader_elector"] = leaderElectorExtensionName
+	}
+}
+
+// configureFileStorage configures the file_storage extension for the
+// OpenTelemetry collector, which persists the sending queues of the exporters
+// on a persistent volume, so that they survive restarts of the collector.
+//
+// The persistent volume claims are deleted along with the collector, i.e. the
+// queued data is not migrated to another seed.
+func (a *Actuator) configureFileStorage(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	cfg config.CollectorStorageConfig,
+) {
+	if obj == nil {
+		return
+	}
+
+	if obj.Spec.Config.Extensions == nil {
+		obj.Spec.Config.Extensions = &otelv1beta1.AnyConfig{}
+	}
+
+	if obj.Spec.Config.Extensions.Object == nil {
+		obj.Spec.Config.Extensions.Object = make(map[string]any)
+	}
+
+	obj.Spec.Config.Extensions.Object[fileStorageExtensionName] = map[string]any{
+		"directory":        volumeMountPathFileStorage,
+		"create_directory": true,
+	}
+
+	obj.Spec.Config.Service.Extensions = append(obj.Spec.Config.Service.Extensions, fileStorageExtensionName)
+
+	size := resource.MustParse(defaultFileStorageSize)
+	if cfg.Size != nil {
+		size = *cfg.Size
+	}
+
+	obj.Spec.VolumeClaimTemplates = append(
+		obj.Spec.VolumeClaimTemplates,
+		corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: volumeNameFileStorage,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				StorageClassName: cfg.StorageClassName,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: size,
+					},
+				},
+			},
+		},
+	)
+
+	obj.Spec.PersistentVolumeClaimRetentionPolicy = &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+		WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+	}
+
+	obj.Spec.VolumeMounts = append(
+		obj.Spec.VolumeMounts,
+		corev1.VolumeMount{
+			Name:      volumeNameFileStorage,
+			MountPath: volumeMountPathFileStorage,
+		},
+	)
+
+	if obj.Spec.PodSecurityContext == nil {
+		obj.Spec.PodSecurityContext = &corev1.PodSecurityContext{}
+	}
+	obj.Spec.PodSecurityContext.FSGroup = ptr.To(otelCollectorGroupID)
+}
+
+// configureEnvFromSecret configures an environment variable for the
+// OpenTelemetry collector, which is sourced from the referenced secret.
+func (a *Actuator) configureEnvFromSecret(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	name string,
+	ref *config.ResourceReference,
+	resources []gardencorev1beta1.NamedResourceReference,
+) {
+	if obj == nil || ref == nil {
+		return
+	}
+
+	obj.Spec.Env = append(
+		obj.Spec.Env,
+		corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secretNameForResource(ref.ResourceRef.Name, resources),
+					},
+					Key: ref.ResourceRef.DataKey,
+				},
+			},
+		},
+	)
+}
+
+// configureVolumeForWAL configures an ephemeral volume for the OpenTelemetry
+// collector, which stores the Write-Ahead Log of an exporter.
+func (a *Actuator) configureVolumeForWAL(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	volumeName string,
+	volumeMount string,
+) {
+	if obj == nil {
+		return
+	}
+
+	obj.Spec.Volumes = append(
+		obj.Spec.Volumes,
+		corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	)
+
+	obj.Spec.VolumeMounts = append(
+		obj.Spec.VolumeMounts,
+		corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: volumeMount,
+		},
+	)
+}
+
 // configureVolumeForBearerTokenAuthExtension configures a volume for the
 // OpenTelemetry collector for the bearertokenauth extension.
 func (a *Actuator) configureVolumeForBearerTokenAuthExtension(
@@ -1494,3 +2946,112 @@ func (a *Actuator) configureVolumeForBearerTokenAuthExtension(
 		},
 	)
 }
+
+// getCollectorStatus returns the [config.CollectorStatus] for the given
+// collector configuration, the rendered [otelv1beta1.OpenTelemetryCollector],
+// the images of the collector and the Target Allocator, and the PEM-encoded
+// certificates keyed by their names.
+func getCollectorStatus(
+	cfg config.CollectorConfig,
+	collector *otelv1beta1.OpenTelemetryCollector,
+	collectorImage *imagevectorutils.Image,
+	taImage *imagevectorutils.Image,
+	certificates map[string][]byte,
+) (*config.CollectorStatus, error) {
+	// The config is marshaled by reference, since the receivers, exporters
+	// and the other components implement [json.Marshaler] on the pointer.
+	renderedConfig, err := json.Marshal(&collector.Spec.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal collector config: %w", err)
+	}
+
+	status := &config.CollectorStatus{
+		Pipelines:              slices.Sorted(maps.Keys(collector.Spec.Config.Service.Pipelines)),
+		Exporters:              make([]config.ExporterStatus, 0),
+		ConfigHash:             utils.ComputeSHA256Hex(renderedConfig),
+		CollectorVersion:       getImageVersion(collectorImage),
+		TargetAllocatorVersion: getImageVersion(taImage),
+		Certificates:           make([]config.CertificateStatus, 0),
+	}
+
+	exporters := collector.Spec.Config.Exporters.Object
+	for _, name := range slices.Sorted(maps.Keys(exporters)) {
+		exporter, _ := exporters[name].(map[string]any)
+		status.Exporters = append(status.Exporters, config.ExporterStatus{
+			Name:     name,
+			Endpoint: getExporterEndpoint(exporter),
+		})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(certificates)) {
+		notAfter, err := getCertificateNotAfter(certificates[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate %s: %w", name, err)
+		}
+
+		status.Certificates = append(status.Certificates, config.CertificateStatus{
+			Name:     name,
+			NotAfter: metav1.NewTime(notAfter),
+		})
+	}
+
+	return status, nil
+}
+
+// getExporterEndpoint returns the endpoint of the given rendered exporter
+// config. The brokers of the Kafka exporter are returned comma-separated.
+func getExporterEndpoint(exporter map[string]any) string {
+	if endpoint, ok := exporter[configKeyEndpoint].(string); ok {
+		return endpoint
+	}
+
+	if brokers, ok := exporter["brokers"].([]string); ok {
+		return strings.Join(brokers, ",")
+	}
+
+	return ""
+}
+
+// getImageVersion returns the version of the given image, falling back to
+// its tag.
+func getImageVersion(image *imagevectorutils.Image) string {
+	if image.Version != nil {
+		return *image.Version
+	}
+
+	return ptr.Deref(image.Tag, "")
+}
+
+// getCertificateNotAfter returns the expiry of the first certificate in the
+// given PEM-encoded data.
+func getCertificateNotAfter(data []byte) (time.Time, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, errors.New("no PEM data found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
+
+// updateProviderStatus writes the given [config.CollectorStatus] into the
+// provider status of the [extensionsv1alpha1.Extension] resource.
+func (a *Actuator) updateProviderStatus(ctx context.Context, ex *extensionsv1alpha1.Extension, status *config.CollectorStatus) error {
+	obj := &v1alpha1.CollectorStatus{}
+	if err := v1alpha1.Convert_config_CollectorStatus_To_v1alpha1_CollectorStatus(status, obj, nil); err != nil {
+		return fmt.Errorf("failed to convert collector status: %w", err)
+	}
+	obj.SetGroupVersionKind(schema.GroupVersion(v1alpha1.GroupVersion).WithKind("CollectorStatus"))
+
+	patch := client.MergeFrom(ex.DeepCopy())
+	ex.Status.ProviderStatus = &runtime.RawExtension{Object: obj}
+	if err := a.client.Status().Patch(ctx, ex, patch); err != nil {
+		return fmt.Errorf("failed to update provider status: %w", err)
+	}
+
+	return nil
+}
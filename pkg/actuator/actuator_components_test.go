@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+var _ = Describe("ValidateComponentConfigs", func() {
+	var collectorConfig otelv1beta1.Config
+
+	BeforeEach(func() {
+		collectorConfig = otelv1beta1.Config{
+			Processors: &otelv1beta1.AnyConfig{
+				Object: map[string]any{
+					batchProcessorName: map[string]any{
+						"timeout":         "5s",
+						"send_batch_size": uint32(8192),
+					},
+					memoryLimiterProcessorName: map[string]any{
+						"check_interval":   "1s",
+						"limit_percentage": uint32(75),
+					},
+				},
+			},
+		}
+	})
+
+	It("should succeed for the config rendered by the actuator", func() {
+		a, err := New(fake.NewClientBuilder().Build())
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					DebugExporter: config.DebugExporterConfig{
+						Enabled:   new(true),
+						Verbosity: config.DebugExporterVerbosityBasic,
+					},
+					OTLPHTTPExporter: config.OTLPHTTPExporterConfig{
+						Enabled:  new(true),
+						Endpoint: "https://otlp.example.org:4318",
+						Encoding: config.MessageEncodingProto,
+					},
+					OTLPGRPCExporter: config.OTLPGRPCExporterConfig{
+						Enabled:  new(true),
+						Endpoint: "otlp.example.org:4317",
+					},
+					PrometheusRemoteWriteExporter: config.PrometheusRemoteWriteExporterConfig{
+						Enabled:  new(true),
+						Endpoint: "https://thanos.example.org/api/v1/receive",
+					},
+					KafkaExporter: config.KafkaExporterConfig{
+						Enabled: new(true),
+						Brokers: []string{"kafka.example.org:9092"},
+					},
+				},
+			},
+		}
+		cluster := &extensionscontroller.Cluster{
+			Shoot: &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: "garden-local"},
+			},
+		}
+
+		collector := a.getOtelCollector(
+			"shoot--local--local",
+			newStubSecret("shoot--local--local", "ca"),
+			newStubSecret("shoot--local--local", "client"),
+			cfg,
+			cluster,
+			"generic-token-kubeconfig",
+			shootAccessSecretName,
+			&imagevectorutils.Image{Name: "otel-collector", Repository: ptr.To("otel/collector"), Tag: ptr.To("latest")},
+		)
+		Expect(ValidateComponentConfigs(collector.Spec.Config)).To(Succeed())
+	})
+
+	It("should succeed for valid settings", func() {
+		Expect(ValidateComponentConfigs(collectorConfig)).To(Succeed())
+	})
+
+	It("should succeed for components without settings", func() {
+		collectorConfig.Processors.Object[batchProcessorName] = nil
+		Expect(ValidateComponentConfigs(collectorConfig)).To(Succeed())
+	})
+
+	It("should fail for unknown components", func() {
+		collectorConfig.Processors.Object["groupbyattrs/events"] = map[string]any{"keys": []string{"k8s.namespace.name"}}
+		Expect(ValidateComponentConfigs(collectorConfig)).To(MatchError(And(
+			ContainSubstring("invalid settings of processors groupbyattrs/events"),
+			ContainSubstring(`unknown component type "groupbyattrs"`),
+		)))
+	})
+
+	It("should skip only the components without a registered factory", func() {
+		Expect(unvalidatedComponentTypes).To(Equal(map[string][]string{
+			"receivers":  {"k8sobjects", "prometheus"},
+			"exporters":  {"prometheusremotewrite"},
+			"extensions": {"k8s_leader_elector"},
+		}))
+
+		collectorConfig.Receivers.Object = map[string]any{"prometheus": map[string]any{"unknown_key": true}}
+		Expect(ValidateComponentConfigs(collectorConfig)).To(Succeed())
+
+		collectorConfig.Receivers.Object = map[string]any{"prometheus": nil}
+		collectorConfig.Processors.Object["prometheus"] = nil
+		Expect(ValidateComponentConfigs(collectorConfig)).To(MatchError(ContainSubstring("invalid settings of processors prometheus")))
+	})
+
+	It("should fail for unknown keys", func() {
+		collectorConfig.Processors.Object[batchProcessorName] = map[string]any{"read_buffer_size": 1024}
+		Expect(ValidateComponentConfigs(collectorConfig)).To(MatchError(And(
+			ContainSubstring("invalid settings of processors batch"),
+			ContainSubstring("read_buffer_size"),
+		)))
+	})
+
+	It("should fail for unknown keys of an exporter", func() {
+		collectorConfig.Exporters.Object = map[string]any{
+			"debug": map[string]any{"verbosity": "basic", "endpoint": "localhost:4317"},
+		}
+		Expect(ValidateComponentConfigs(collectorConfig)).To(MatchError(And(
+			ContainSubstring("invalid settings of exporters debug"),
+			ContainSubstring("endpoint"),
+		)))
+	})
+
+	It("should fail for invalid settings", func() {
+		collectorConfig.Processors.Object[memoryLimiterProcessorName] = map[string]any{"check_interval": "0s"}
+		Expect(ValidateComponentConfigs(collectorConfig)).To(MatchError(ContainSubstring("invalid settings of processors memory_limiter")))
+	})
+
+	It("should validate components with a name", func() {
+		collectorConfig.Processors.Object["batch/traces"] = map[string]any{"send_batch_max_size": uint32(1), "send_batch_size": uint32(2)}
+		Expect(ValidateComponentConfigs(collectorConfig)).To(MatchError(ContainSubstring("invalid settings of processors batch/traces")))
+	})
+})
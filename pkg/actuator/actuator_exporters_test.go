@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+var _ = Describe("exporter resource names", func() {
+	DescribeTable("should derive the names from the exporter id",
+		func(id, wantAuthName, wantVolumeTLS, wantMountTLS, wantVolumeToken, wantMountToken string) {
+			Expect(exporterBearerTokenAuthName(id)).To(Equal(wantAuthName))
+			Expect(exporterVolumeNameTLS(id)).To(Equal(wantVolumeTLS))
+			Expect(exporterVolumeMountPathTLS(id)).To(Equal(wantMountTLS))
+			Expect(exporterVolumeNameBearerToken(id)).To(Equal(wantVolumeToken))
+			Expect(exporterVolumeMountPathBearerTokenFile(id)).To(Equal(wantMountToken))
+		},
+		Entry("OTLP HTTP exporter",
+			"otlp_http",
+			"bearertokenauth/exporter-otlp-http",
+			"tls-exporter-otlp-http",
+			"/etc/ssl/tls-exporter-otlp-http",
+			"bearer-token-auth-exporter-otlp-http",
+			"/etc/auth/bearer-exporter-otlp-http",
+		),
+		Entry("OTLP gRPC exporter",
+			"otlp_grpc",
+			"bearertokenauth/exporter-otlp-grpc",
+			"tls-exporter-otlp-grpc",
+			"/etc/ssl/tls-exporter-otlp-grpc",
+			"bearer-token-auth-exporter-otlp-grpc",
+			"/etc/auth/bearer-exporter-otlp-grpc",
+		),
+		Entry("named OTLP HTTP exporter",
+			"otlp_http/backup",
+			"bearertokenauth/exporter-otlp-http-backup",
+			"tls-exporter-otlp-http-backup",
+			"/etc/ssl/tls-exporter-otlp-http-backup",
+			"bearer-token-auth-exporter-otlp-http-backup",
+			"/etc/auth/bearer-exporter-otlp-http-backup",
+		),
+	)
+})
+
+var _ = Describe("getExporterSecurityConfigs", func() {
+	token := &config.ResourceReference{
+		ResourceRef: config.ResourceReferenceDetails{Name: "token", DataKey: "token"},
+	}
+
+	It("should return the settings of the enabled exporters only", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					OTLPHTTPExporter: config.OTLPHTTPExporterConfig{Enabled: new(true), Token: token},
+					OTLPGRPCExporter: config.OTLPGRPCExporterConfig{Enabled: new(false), Token: token},
+					NamedOTLPHTTPExporters: []config.NamedOTLPHTTPExporterConfig{
+						{Name: "backup", OTLPHTTPExporterConfig: config.OTLPHTTPExporterConfig{Enabled: new(true), Token: token}},
+						{Name: "disabled", OTLPHTTPExporterConfig: config.OTLPHTTPExporterConfig{Enabled: new(false)}},
+					},
+					NamedOTLPGRPCExporters: []config.NamedOTLPGRPCExporterConfig{
+						{Name: "tenant", OTLPGRPCExporterConfig: config.OTLPGRPCExporterConfig{Enabled: new(true)}},
+					},
+				},
+			},
+		}
+
+		ids := make([]string, 0)
+		for _, exporter := range getExporterSecurityConfigs(cfg) {
+			ids = append(ids, exporter.id)
+		}
+		Expect(ids).To(Equal([]string{"otlp_http", "otlp_http/backup", "otlp_grpc/tenant"}))
+	})
+})
+
+var _ = Describe("getReferencedResourceNames", func() {
+	ref := func(name, key string) *config.ResourceReference {
+		return &config.ResourceReference{
+			ResourceRef: config.ResourceReferenceDetails{Name: name, DataKey: key},
+		}
+	}
+
+	It("should return the unique resource names of the enabled exporters", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					OTLPHTTPExporter: config.OTLPHTTPExporterConfig{
+						Enabled: new(true),
+						Token:   ref("token", "token"),
+						TLS:     &config.TLSConfig{CA: ref("tls", "ca.crt"), Cert: ref("tls", "tls.crt")},
+					},
+					OTLPGRPCExporter: config.OTLPGRPCExporterConfig{
+						Enabled: new(false),
+						Token:   ref("disabled", "token"),
+					},
+					KafkaExporter: config.KafkaExporterConfig{
+						Enabled: new(true),
+						SASL: &config.KafkaSASLConfig{
+							Username: ref("kafka", "username"),
+							Password: ref("kafka", "password"),
+						},
+					},
+				},
+			},
+		}
+
+		Expect(getReferencedResourceNames(cfg)).To(Equal([]string{"kafka", "tls", "token"}))
+	})
+})
+
+var _ = Describe("getPrometheusRemoteWriteExporterConfig", func() {
+	It("should render the exporter settings", func() {
+		a := &Actuator{}
+		exporter := a.getPrometheusRemoteWriteExporterConfig(config.PrometheusRemoteWriteExporterConfig{
+			Enabled:        new(true),
+			Endpoint:       "https://thanos.example.org/api/v1/receive",
+			Timeout:        5 * time.Second,
+			ExternalLabels: map[string]string{"cluster": "my-shoot"},
+			WAL: config.PrometheusRemoteWriteWALConfig{
+				Enabled:           new(true),
+				BufferSize:        300,
+				TruncateFrequency: time.Minute,
+			},
+			Token: &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "token", DataKey: "token"},
+			},
+		})
+
+		Expect(exporter).To(Equal(map[string]any{
+			"endpoint":        "https://thanos.example.org/api/v1/receive",
+			"timeout":         "5s",
+			"external_labels": map[string]string{"cluster": "my-shoot"},
+			"wal": map[string]any{
+				"directory":          "/var/lib/otelcol/wal-exporter-prometheusremotewrite",
+				"buffer_size":        300,
+				"truncate_frequency": "1m0s",
+			},
+			"auth": map[string]any{
+				"authenticator": "bearertokenauth/exporter-prometheusremotewrite",
+			},
+		}))
+	})
+})
+
+var _ = Describe("getKafkaExporterConfig", func() {
+	It("should render the exporter settings", func() {
+		a := &Actuator{}
+		exporter := a.getKafkaExporterConfig(config.KafkaExporterConfig{
+			Enabled:  new(true),
+			Brokers:  []string{"kafka-0.example.org:9093", "kafka-1.example.org:9093"},
+			Timeout:  5 * time.Second,
+			Encoding: config.KafkaEncodingOTLPJSON,
+			Topics: config.KafkaTopicsConfig{
+				Logs:    "logs",
+				Metrics: "metrics",
+				Traces:  "spans",
+			},
+			SASL: &config.KafkaSASLConfig{
+				Mechanism: config.KafkaSASLMechanismSCRAMSHA512,
+				Username: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "kafka", DataKey: "username"},
+				},
+				Password: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "kafka", DataKey: "password"},
+				},
+			},
+		})
+
+		Expect(exporter).To(Equal(map[string]any{
+			"brokers": []string{"kafka-0.example.org:9093", "kafka-1.example.org:9093"},
+			"timeout": "5s",
+			"logs":    map[string]any{"topic": "logs", "encoding": "otlp_json"},
+			"metrics": map[string]any{"topic": "metrics", "encoding": "otlp_json"},
+			"traces":  map[string]any{"topic": "spans", "encoding": "otlp_json"},
+			"auth": map[string]any{
+				"sasl": map[string]any{
+					"mechanism": "SCRAM-SHA-512",
+					"username":  "${env:KAFKA_EXPORTER_SASL_USERNAME}",
+					"password":  "${env:KAFKA_EXPORTER_SASL_PASSWORD}",
+				},
+			},
+		}))
+	})
+})
+
+var _ = Describe("sending queue", func() {
+	persistentQueue := config.SendingQueueConfig{
+		Enabled:      new(true),
+		NumConsumers: 10,
+		QueueSize:    1000,
+		Storage:      config.SendingQueueStorageFile,
+	}
+
+	It("should reference the file storage for persistent queues", func() {
+		Expect(getSendingQueueConfig(persistentQueue)).To(Equal(map[string]any{
+			"enabled":       true,
+			"num_consumers": 10,
+			"queue_size":    1000,
+			"storage":       "file_storage/queue",
+		}))
+	})
+
+	It("should keep in-memory queues without storage", func() {
+		queue := persistentQueue
+		queue.Storage = config.SendingQueueStorageMemory
+		Expect(getSendingQueueConfig(queue)).NotTo(HaveKey("storage"))
+	})
+
+	It("should use the file storage only for enabled exporters", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					OTLPHTTPExporter: config.OTLPHTTPExporterConfig{Enabled: new(false), SendingQueue: persistentQueue},
+					OTLPGRPCExporter: config.OTLPGRPCExporterConfig{Enabled: new(true)},
+				},
+			},
+		}
+		Expect(usesFileStorage(cfg)).To(BeFalse())
+
+		cfg.Spec.Exporters.NamedOTLPGRPCExporters = []config.NamedOTLPGRPCExporterConfig{
+			{
+				Name:                   "tenant",
+				OTLPGRPCExporterConfig: config.OTLPGRPCExporterConfig{Enabled: new(true), SendingQueue: persistentQueue},
+			},
+		}
+		Expect(usesFileStorage(cfg)).To(BeTrue())
+	})
+})
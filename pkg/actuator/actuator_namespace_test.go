@@ -5,8 +5,13 @@
 package actuator
 
 import (
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
 )
 
 var _ = Describe("parseShootNamespaceAttributes", func() {
@@ -35,3 +40,52 @@ var _ = Describe("parseShootNamespaceAttributes", func() {
 		),
 	)
 })
+
+var _ = Describe("getResourceAttributes", func() {
+	const namespace = "shoot--my-project--my-shoot"
+
+	cluster := &extensionscontroller.Cluster{
+		Seed: &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-eu1"},
+		},
+		Shoot: &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-shoot",
+				UID:         "2ab0b1a7-6d5c-4d5e-9f0a-8b1c3c7e9d10",
+				Labels:      map[string]string{"team": "observability", "tier": "gold"},
+				Annotations: map[string]string{"example.org/cost-center": "42"},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Region:     "eu-west-1",
+				Provider:   gardencorev1beta1.Provider{Type: "aws"},
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.33.2"},
+			},
+		},
+	}
+
+	It("should derive the attributes from the cluster", func() {
+		attributes := getResourceAttributes(namespace, cluster, config.ResourceAttributesConfig{
+			Static:           map[string]string{"deployment.environment.name": "production"},
+			ShootLabels:      []string{"team", "missing"},
+			ShootAnnotations: []string{"example.org/cost-center"},
+		})
+
+		Expect(attributes).To(Equal([]any{
+			upsertAttribute("k8s.cluster.name", namespace),
+			upsertAttribute("gardener.project.name", "my-project"),
+			upsertAttribute("gardener.shoot.name", "my-shoot"),
+			upsertAttribute("gardener.seed.name", "aws-eu1"),
+			upsertAttribute("gardener.shoot.uid", "2ab0b1a7-6d5c-4d5e-9f0a-8b1c3c7e9d10"),
+			upsertAttribute("gardener.shoot.region", "eu-west-1"),
+			upsertAttribute("gardener.shoot.provider.type", "aws"),
+			upsertAttribute("gardener.shoot.kubernetes.version", "1.33.2"),
+			upsertAttribute("gardener.shoot.label.team", "observability"),
+			upsertAttribute("gardener.shoot.annotation.example.org/cost-center", "42"),
+			upsertAttribute("deployment.environment.name", "production"),
+		}))
+	})
+
+	It("should only parse the namespace without a cluster", func() {
+		Expect(getResourceAttributes(namespace, nil, config.ResourceAttributesConfig{})).To(HaveLen(3))
+	})
+})
@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+var _ = Describe("getOtelPipelines", func() {
+	exporters := config.CollectorExportersConfig{
+		DebugExporter:    config.DebugExporterConfig{Enabled: new(true)},
+		OTLPGRPCExporter: config.OTLPGRPCExporterConfig{Enabled: new(true)},
+		OTLPHTTPExporter: config.OTLPHTTPExporterConfig{Enabled: new(true)},
+	}
+	exporterNames := []string{"debug", "otlp_grpc", "otlp_http"}
+
+	It("should enable the logs, events and metrics pipelines by default", func() {
+		pipelines := getOtelPipelines(config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{Exporters: exporters},
+		})
+		Expect(slices.Sorted(maps.Keys(pipelines))).To(Equal([]string{"logs", "logs/events", "metrics"}))
+		for _, pipeline := range pipelines {
+			Expect(pipeline.Exporters).To(Equal(exporterNames))
+		}
+	})
+
+	It("should add the traces pipeline when enabled", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: exporters,
+				Pipelines: config.CollectorPipelinesConfig{
+					Traces: config.TracesPipelineConfig{Enabled: new(true)},
+				},
+			},
+		}
+
+		pipelines := getOtelPipelines(cfg)
+		Expect(pipelines).To(HaveKey("traces"))
+		Expect(pipelines["traces"].Receivers).To(Equal([]string{"otlp"}))
+		Expect(pipelines["traces"].Processors).To(Equal([]string{
+			resourceProcessorName,
+			memoryLimiterProcessorName,
+			batchProcessorName,
+		}))
+		Expect(pipelines["traces"].Exporters).To(Equal(exporterNames))
+	})
+
+	It("should skip the disabled pipelines", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: exporters,
+				Pipelines: config.CollectorPipelinesConfig{
+					Logs:    config.PipelineConfig{Enabled: new(false)},
+					Events:  config.PipelineConfig{Enabled: new(false)},
+					Metrics: config.PipelineConfig{Enabled: new(true)},
+					Traces:  config.TracesPipelineConfig{Enabled: new(true)},
+				},
+			},
+		}
+
+		pipelines := getOtelPipelines(cfg)
+		Expect(slices.Sorted(maps.Keys(pipelines))).To(Equal([]string{"metrics", "traces"}))
+	})
+
+	It("should route the signals to the configured exporters only", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: exporters,
+				Pipelines: config.CollectorPipelinesConfig{
+					Logs:    config.PipelineConfig{Exporters: []string{"otlp_http"}},
+					Events:  config.PipelineConfig{Exporters: []string{"otlp_http", "debug"}},
+					Metrics: config.PipelineConfig{Exporters: []string{"otlp_grpc"}},
+				},
+			},
+		}
+
+		pipelines := getOtelPipelines(cfg)
+		Expect(pipelines["logs"].Exporters).To(Equal([]string{"otlp_http"}))
+		Expect(pipelines["logs/events"].Exporters).To(Equal([]string{"debug", "otlp_http"}))
+		Expect(pipelines["metrics"].Exporters).To(Equal([]string{"otlp_grpc"}))
+	})
+
+	It("should route the metrics only to exporters supporting the signal", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					DebugExporter:                 config.DebugExporterConfig{Enabled: new(true)},
+					PrometheusRemoteWriteExporter: config.PrometheusRemoteWriteExporterConfig{Enabled: new(true)},
+				},
+			},
+		}
+
+		pipelines := getOtelPipelines(cfg)
+		Expect(pipelines["logs"].Exporters).To(Equal([]string{"debug"}))
+		Expect(pipelines["logs/events"].Exporters).To(Equal([]string{"debug"}))
+		Expect(pipelines["metrics"].Exporters).To(Equal([]string{"debug", "prometheusremotewrite"}))
+	})
+})
+
+var _ = Describe("sampling processors", func() {
+	sampling := config.SamplingConfig{
+		Probabilistic: config.ProbabilisticSamplerConfig{
+			Enabled:            new(true),
+			SamplingPercentage: 25,
+		},
+		TailSampling: config.TailSamplingConfig{
+			Enabled:      new(true),
+			DecisionWait: 10 * time.Second,
+			NumTraces:    1000,
+			Policies: []config.TailSamplingPolicy{
+				{
+					Name:    "slow-traces",
+					Type:    config.TailSamplingPolicyTypeLatency,
+					Latency: &config.LatencyPolicyConfig{ThresholdMs: 500},
+				},
+				{
+					Name:       "errors",
+					Type:       config.TailSamplingPolicyTypeStatusCode,
+					StatusCode: &config.StatusCodePolicyConfig{StatusCodes: []string{"ERROR"}},
+				},
+			},
+		},
+	}
+
+	It("should insert the samplers between the memory_limiter and batch processors", func() {
+		Expect(getTracesProcessors(config.CollectorConfig{Spec: config.CollectorConfigSpec{Sampling: sampling}})).To(Equal([]string{
+			resourceProcessorName,
+			memoryLimiterProcessorName,
+			probabilisticSamplerProcessorName,
+			tailSamplingProcessorName,
+			batchProcessorName,
+		}))
+	})
+
+	It("should not add any processor when sampling is disabled", func() {
+		Expect(getTracesProcessors(config.CollectorConfig{})).To(Equal([]string{
+			resourceProcessorName,
+			memoryLimiterProcessorName,
+			batchProcessorName,
+		}))
+		Expect(getSamplingProcessors(config.SamplingConfig{})).To(BeEmpty())
+	})
+
+	It("should render the settings of the samplers", func() {
+		Expect(getSamplingProcessors(sampling)).To(Equal(map[string]any{
+			probabilisticSamplerProcessorName: map[string]any{
+				"sampling_percentage": 25.0,
+			},
+			tailSamplingProcessorName: map[string]any{
+				"decision_wait": "10s",
+				"num_traces":    1000,
+				"policies": []any{
+					map[string]any{
+						"name":    "slow-traces",
+						"type":    "latency",
+						"latency": map[string]any{"threshold_ms": int64(500)},
+					},
+					map[string]any{
+						"name":        "errors",
+						"type":        "status_code",
+						"status_code": map[string]any{"status_codes": []string{"ERROR"}},
+					},
+				},
+			},
+		}))
+	})
+})
+
+var _ = Describe("filter processors", func() {
+	filters := config.FiltersConfig{
+		ErrorMode: config.FilterErrorModeIgnore,
+		Logs: config.LogsFilterConfig{
+			LogRecord: []string{`severity_number < SEVERITY_NUMBER_INFO`},
+		},
+		Metrics: config.MetricsFilterConfig{
+			Metric: []string{`name == "apiserver_request_duration_seconds_bucket"`},
+		},
+		Traces: config.TracesFilterConfig{
+			Span: []string{`attributes["http.route"] == "/healthz"`},
+		},
+	}
+
+	It("should insert the filters into the pipelines of the signals", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Filters: filters,
+				Sampling: config.SamplingConfig{
+					TailSampling: config.TailSamplingConfig{Enabled: new(true)},
+				},
+			},
+		}
+
+		Expect(getLogsProcessors(cfg)).To(Equal([]string{
+			resourceProcessorName,
+			memoryLimiterProcessorName,
+			filterLogsProcessorName,
+			batchProcessorName,
+		}))
+		Expect(getMetricsProcessors(cfg)).To(Equal([]string{
+			resourceProcessorName,
+			memoryLimiterProcessorName,
+			filterMetricsProcessorName,
+			batchProcessorName,
+		}))
+		Expect(getTracesProcessors(cfg)).To(Equal([]string{
+			resourceProcessorName,
+			memoryLimiterProcessorName,
+			filterTracesProcessorName,
+			tailSamplingProcessorName,
+			batchProcessorName,
+		}))
+	})
+
+	It("should render the filter processors with conditions only", func() {
+		cfg := filters
+		cfg.Traces = config.TracesFilterConfig{}
+
+		Expect(getFilterProcessors(cfg)).To(Equal(map[string]any{
+			filterLogsProcessorName: map[string]any{
+				"error_mode": "ignore",
+				"logs": map[string]any{
+					"log_record": []string{`severity_number < SEVERITY_NUMBER_INFO`},
+				},
+			},
+			filterMetricsProcessorName: map[string]any{
+				"error_mode": "ignore",
+				"metrics": map[string]any{
+					"metric": []string{`name == "apiserver_request_duration_seconds_bucket"`},
+				},
+			},
+		}))
+	})
+})
+
+var _ = Describe("events processors", func() {
+	events := config.EventsConfig{
+		ExcludeNamespaces: []string{"garden"},
+		Types:             []config.EventType{config.EventTypeWarning},
+		ExcludeReasons:    []string{"Pulled", "Created"},
+	}
+
+	It("should not filter the events by default", func() {
+		Expect(getEventsProcessors(config.CollectorConfig{})).To(Equal([]string{
+			resourceProcessorName,
+			memoryLimiterProcessorName,
+			transformEventsProcessorName,
+			batchProcessorName,
+		}))
+		Expect(getEventsFilterProcessors(config.EventsConfig{})).To(BeEmpty())
+	})
+
+	It("should filter the events before transforming them", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{Events: events},
+		}
+
+		Expect(getEventsProcessors(cfg)).To(Equal([]string{
+			resourceProcessorName,
+			memoryLimiterProcessorName,
+			filterEventsProcessorName,
+			transformEventsProcessorName,
+			batchProcessorName,
+		}))
+	})
+
+	It("should render the conditions for the watched and the pulled events", func() {
+		Expect(getEventsFilterProcessors(events)).To(Equal(map[string]any{
+			filterEventsProcessorName: map[string]any{
+				"error_mode": "ignore",
+				"logs": map[string]any{
+					"log_record": []string{
+						`body["object"]["kind"] == "Event" and IsMatch(body["object"]["metadata"]["namespace"], "^(garden)$")`,
+						`body["object"]["kind"] == "Event" and not IsMatch(body["object"]["type"], "^(Warning)$")`,
+						`body["object"]["kind"] == "Event" and IsMatch(body["object"]["reason"], "^(Pulled|Created)$")`,
+						`body["kind"] == "Event" and IsMatch(body["metadata"]["namespace"], "^(garden)$")`,
+						`body["kind"] == "Event" and not IsMatch(body["type"], "^(Warning)$")`,
+						`body["kind"] == "Event" and IsMatch(body["reason"], "^(Pulled|Created)$")`,
+					},
+				},
+			},
+		}))
+	})
+})
+
+var _ = Describe("kubernetes objects", func() {
+	It("should restrict the events to the configured namespaces", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				KubernetesObjects: []config.KubernetesObjectConfig{
+					{Name: "events", Mode: config.KubernetesObjectModeWatch},
+					{Name: "nodes", Mode: config.KubernetesObjectModePull},
+				},
+				Events: config.EventsConfig{
+					Namespaces:    []string{"kube-system"},
+					OnlyNewEvents: new(true),
+				},
+			},
+		}
+
+		objects := getKubernetesObjects(cfg)
+		Expect(objects[0].Namespaces).To(Equal([]string{"kube-system"}))
+		Expect(objects[1].Namespaces).To(BeEmpty())
+		Expect(cfg.Spec.KubernetesObjects[0].Namespaces).To(BeEmpty())
+
+		Expect(getKubernetesObjectsReceiverConfig(objects, cfg.Spec.Events)).To(Equal([]any{
+			map[string]any{
+				"name":               "events",
+				"mode":               "watch",
+				"namespaces":         []string{"kube-system"},
+				"exclude_watch_type": []string{"MODIFIED", "DELETED"},
+			},
+			map[string]any{
+				"name":     "nodes",
+				"mode":     "pull",
+				"interval": "1h0m0s",
+			},
+		}))
+	})
+})
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"time"
+
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+var _ = Describe("collector status", func() {
+	var (
+		collector      *otelv1beta1.OpenTelemetryCollector
+		collectorImage = &imagevectorutils.Image{Name: "otel-collector", Tag: ptr.To("v0.156.0")}
+		taImage        = &imagevectorutils.Image{Name: "otel-target-allocator", Tag: ptr.To("latest"), Version: ptr.To("v0.156.1")}
+	)
+
+	BeforeEach(func() {
+		collector = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Exporters: otelv1beta1.AnyConfig{
+						Object: map[string]any{
+							"debug":             map[string]any{"verbosity": "basic"},
+							"otlp_http":         map[string]any{"endpoint": "https://example.com:4318"},
+							"otlp_grpc/backend": map[string]any{"endpoint": "otel.example.com:4317"},
+							"kafka":             map[string]any{"brokers": []string{"kafka-0:9092", "kafka-1:9092"}},
+						},
+					},
+					Service: otelv1beta1.Service{
+						Pipelines: map[string]*otelv1beta1.Pipeline{
+							"traces": {Exporters: []string{"otlp_http"}},
+							"logs":   {Exporters: []string{"debug"}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should list the pipelines, exporters and image versions", func() {
+		status, err := getCollectorStatus(config.CollectorConfig{}, collector, collectorImage, taImage, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(status.Pipelines).To(Equal([]string{"logs", "traces"}))
+		Expect(status.Exporters).To(Equal([]config.ExporterStatus{
+			{Name: "debug"},
+			{Name: "kafka", Endpoint: "kafka-0:9092,kafka-1:9092"},
+			{Name: "otlp_grpc/backend", Endpoint: "otel.example.com:4317"},
+			{Name: "otlp_http", Endpoint: "https://example.com:4318"},
+		}))
+		Expect(status.CollectorVersion).To(Equal("v0.156.0"))
+		Expect(status.TargetAllocatorVersion).To(Equal("v0.156.1"))
+		Expect(status.Certificates).To(BeEmpty())
+	})
+
+	It("should change the config hash with the rendered config", func() {
+		status, err := getCollectorStatus(config.CollectorConfig{}, collector, collectorImage, taImage, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.ConfigHash).To(HaveLen(64))
+
+		same, err := getCollectorStatus(config.CollectorConfig{}, collector, collectorImage, taImage, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same.ConfigHash).To(Equal(status.ConfigHash))
+
+		collector.Spec.Config.Exporters.Object["otlp_http"] = map[string]any{"endpoint": "https://other.example.com:4318"}
+		changed, err := getCollectorStatus(config.CollectorConfig{}, collector, collectorImage, taImage, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed.ConfigHash).NotTo(Equal(status.ConfigHash))
+	})
+
+	It("should report the expiry of the certificates", func() {
+		cert, err := (&secretsutils.CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "ca",
+			CertType:   secretsutils.CACert,
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		status, err := getCollectorStatus(config.CollectorConfig{}, collector, collectorImage, taImage, map[string][]byte{
+			secretNameCACertificate: cert.CertificatePEM,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Certificates).To(HaveLen(1))
+		Expect(status.Certificates[0].Name).To(Equal(secretNameCACertificate))
+		Expect(status.Certificates[0].NotAfter.Time).To(BeTemporally("==", cert.Certificate.NotAfter.Truncate(time.Second)))
+	})
+
+	It("should fail for invalid certificates", func() {
+		_, err := getCollectorStatus(config.CollectorConfig{}, collector, collectorImage, taImage, map[string][]byte{
+			secretNameCACertificate: []byte("invalid"),
+		})
+		Expect(err).To(MatchError(ContainSubstring("invalid certificate ca-otelcol: no PEM data found")))
+	})
+})
@@ -9,9 +9,15 @@ import (
 
 	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenerfeatures "github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -19,6 +25,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/component-base/featuregate"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
 	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
@@ -97,6 +104,24 @@ var _ = Describe("Actuator", Ordered, func() {
 		}
 	)
 
+	// getSeedObjects returns the objects serialized into the managed resource
+	// for the seed cluster.
+	getSeedObjects := func() []client.Object {
+		mr := &resourcesv1alpha1.ManagedResource{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: shootNamespace.Name, Name: actuator.ManagedResourceName}, mr)).To(Succeed())
+
+		var objects []client.Object
+		for _, ref := range mr.Spec.SecretRefs {
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: mr.Namespace, Name: ref.Name}, secret)).To(Succeed())
+			secretObjects, err := managedresources.ExtractObjectsFromSecret(kubernetes.SeedCodec.UniversalDeserializer(), secret)
+			Expect(err).NotTo(HaveOccurred())
+			objects = append(objects, secretObjects...)
+		}
+
+		return objects
+	}
+
 	BeforeAll(func() {
 		actuatorOpts = []actuator.Option{
 			actuator.WithGardenerVersion("1.0.0"),
@@ -219,14 +244,157 @@ var _ = Describe("Actuator", Ordered, func() {
 			Raw: providerConfigData,
 		}
 
+		Expect(k8sClient.Create(ctx, extResource)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, extResource)).To(Succeed())
+		})
+
 		act, err := actuator.New(k8sClient, actuatorOpts...)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(act).NotTo(BeNil())
 		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
 
+		// The provider status contains the effective collector configuration
+		Expect(extResource.Status.ProviderStatus).NotTo(BeNil())
+		var status config.CollectorStatus
+		Expect(runtime.DecodeInto(decoder, extResource.Status.ProviderStatus.Raw, &status)).To(Succeed())
+		Expect(status.Pipelines).NotTo(BeEmpty())
+		Expect(status.Exporters).NotTo(BeEmpty())
+		Expect(status.ConfigHash).NotTo(BeEmpty())
+		Expect(status.Certificates).To(HaveLen(3))
+
 		// TODO(user): Add more tests
 	})
 
+	It("should succeed on Reconcile for a hibernated shoot", func() {
+		hibernatedShoot := shoot.DeepCopy()
+		hibernatedShoot.Spec.Hibernation = &corev1beta1.Hibernation{Enabled: new(true)}
+		data, err := json.Marshal(hibernatedShoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		cluster.Spec.Shoot.Raw = data
+		Expect(k8sClient.Update(ctx, cluster)).To(Succeed())
+
+		autoscaledProviderConfig := providerConfig.DeepCopy()
+		autoscaledProviderConfig.Spec.Scaling.Collector = config.CollectorScalingConfig{
+			Replicas: new(int32(2)),
+			Autoscaling: &config.AutoscalingConfig{
+				MaxReplicas: 3,
+			},
+		}
+		data, err = json.Marshal(autoscaledProviderConfig)
+		Expect(err).NotTo(HaveOccurred())
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: data,
+		}
+
+		Expect(k8sClient.Create(ctx, extResource)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, extResource)).To(Succeed())
+		})
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+
+		// The secrets and managed resources are kept during hibernation
+		Expect(extResource.Status.ProviderStatus).NotTo(BeNil())
+
+		// The serialized collector and Target Allocator are scaled down
+		var collectors, deployments int
+		for _, obj := range getSeedObjects() {
+			switch o := obj.(type) {
+			case *otelv1beta1.OpenTelemetryCollector:
+				collectors++
+				Expect(o.Spec.Replicas).To(Equal(new(int32(0))))
+				Expect(o.Spec.Autoscaler).To(BeNil())
+			case *appsv1.Deployment:
+				deployments++
+				Expect(o.Spec.Replicas).To(Equal(new(int32(0))))
+			}
+		}
+		Expect(collectors).To(Equal(1))
+		Expect(deployments).To(Equal(1))
+	})
+
+	It("should annotate the collector pods with the checksums of the referenced secrets", func() {
+		referencingShoot := shoot.DeepCopy()
+		referencingShoot.Spec.Resources = []corev1beta1.NamedResourceReference{{
+			Name: "otelcol-token",
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "my-otelcol-token",
+			},
+		}}
+		data, err := json.Marshal(referencingShoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		cluster.Spec.Shoot.Raw = data
+		Expect(k8sClient.Update(ctx, cluster)).To(Succeed())
+
+		// The referenced resources are copied into the shoot namespace by
+		// gardenlet with the ref- prefix.
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ref-my-otelcol-token",
+				Namespace: shootNamespace.Name,
+			},
+			Data: map[string][]byte{"token": []byte("my-token")},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+		})
+
+		referencingProviderConfig := providerConfig.DeepCopy()
+		referencingProviderConfig.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://otlp.example.org:4318",
+			Encoding: config.MessageEncodingProto,
+			Token: &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otelcol-token", DataKey: "token"},
+			},
+		}
+		data, err = json.Marshal(referencingProviderConfig)
+		Expect(err).NotTo(HaveOccurred())
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: data,
+		}
+
+		Expect(k8sClient.Create(ctx, extResource)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, extResource)).To(Succeed())
+		})
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		getChecksum := func() string {
+			Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+
+			for _, obj := range getSeedObjects() {
+				if collector, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok {
+					Expect(collector.Spec.PodAnnotations).To(HaveKey("checksum/secret-ref-my-otelcol-token"))
+
+					return collector.Spec.PodAnnotations["checksum/secret-ref-my-otelcol-token"]
+				}
+			}
+			Fail("no collector found in the managed resource")
+
+			return ""
+		}
+
+		checksum := getChecksum()
+		Expect(checksum).NotTo(BeEmpty())
+		Expect(getChecksum()).To(Equal(checksum))
+
+		// The collector pods are rolled out, when the secret data changes
+		secret.Data["token"] = []byte("my-rotated-token")
+		Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+		Expect(getChecksum()).NotTo(Equal(checksum))
+	})
+
 	It("should succeed on Delete", func() {
 		act, err := actuator.New(k8sClient, actuatorOpts...)
 		Expect(err).NotTo(HaveOccurred())
@@ -251,11 +419,25 @@ var _ = Describe("Actuator", Ordered, func() {
 			Raw: providerConfigData,
 		}
 
+		Expect(k8sClient.Create(ctx, extResource)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, extResource)).To(Succeed())
+		})
+
 		act, err := actuator.New(k8sClient, actuatorOpts...)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(act).NotTo(BeNil())
 		Expect(act.Restore(ctx, logger, extResource)).To(Succeed())
 
+		// The provider status contains the effective collector configuration
+		Expect(extResource.Status.ProviderStatus).NotTo(BeNil())
+		var status config.CollectorStatus
+		Expect(runtime.DecodeInto(decoder, extResource.Status.ProviderStatus.Raw, &status)).To(Succeed())
+		Expect(status.Pipelines).NotTo(BeEmpty())
+		Expect(status.Exporters).NotTo(BeEmpty())
+		Expect(status.ConfigHash).NotTo(BeEmpty())
+		Expect(status.Certificates).To(HaveLen(3))
+
 		// TODO(user): Add more tests
 	})
 
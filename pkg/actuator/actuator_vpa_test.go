@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+var _ = Describe("VPAConfig", func() {
+	var cfg VPAConfig
+
+	BeforeEach(func() {
+		cfg = VPAConfig{
+			Enabled:             true,
+			MinAllowed:          corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("50Mi")},
+			MaxAllowed:          corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+			ControlledResources: []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory},
+		}
+	})
+
+	It("should succeed for a valid config", func() {
+		Expect(cfg.Validate()).To(Succeed())
+	})
+
+	It("should fail without controlled resources", func() {
+		cfg.ControlledResources = nil
+		Expect(cfg.Validate()).To(MatchError("no controlled resources specified"))
+	})
+
+	It("should fail for unsupported resources", func() {
+		cfg.ControlledResources = append(cfg.ControlledResources, corev1.ResourceStorage)
+		Expect(cfg.Validate()).To(MatchError("unsupported controlled resource storage"))
+	})
+
+	It("should fail when the max allowed value is lower than the min allowed value", func() {
+		cfg.MaxAllowed[corev1.ResourceMemory] = resource.MustParse("10Mi")
+		Expect(cfg.Validate()).To(MatchError("max allowed memory is lower than the min allowed memory"))
+	})
+})
+
+var _ = Describe("getVPAs", func() {
+	const namespace = "shoot--local--local"
+
+	var a *Actuator
+
+	BeforeEach(func() {
+		var err error
+		a, err = New(fake.NewClientBuilder().Build())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should return no VPAs when disabled", func() {
+		a.vpaConfig.Enabled = false
+		Expect(a.getVPAs(namespace, config.CollectorScalingConfig{})).To(BeEmpty())
+	})
+
+	It("should return the VPAs of the Target Allocator and the collector", func() {
+		vpas := a.getVPAs(namespace, config.CollectorScalingConfig{})
+		Expect(vpas).To(HaveLen(2))
+
+		Expect(vpas[0].Spec.TargetRef.Kind).To(Equal("Deployment"))
+		Expect(vpas[0].Spec.TargetRef.Name).To(Equal(TargetAllocatorDeploymentName))
+		Expect(vpas[1].Spec.TargetRef.Kind).To(Equal("StatefulSet"))
+		Expect(vpas[1].Spec.TargetRef.Name).To(Equal(CollectorStatefulSetName))
+		Expect(vpas[1].Spec.ResourcePolicy.ContainerPolicies[0].ContainerName).To(Equal(otelCollectorContainerName))
+		Expect(*vpas[1].Spec.ResourcePolicy.ContainerPolicies[0].ControlledResources).To(ConsistOf(corev1.ResourceCPU, corev1.ResourceMemory))
+		for _, vpa := range vpas {
+			Expect(vpa.Namespace).To(Equal(namespace))
+		}
+	})
+
+	It("should not control the resources targeted by the horizontal pod autoscaler", func() {
+		vpas := a.getVPAs(namespace, config.CollectorScalingConfig{
+			Autoscaling: &config.AutoscalingConfig{MaxReplicas: 3},
+		})
+		Expect(vpas).To(HaveLen(2))
+		Expect(*vpas[1].Spec.ResourcePolicy.ContainerPolicies[0].ControlledResources).To(ConsistOf(corev1.ResourceMemory))
+	})
+
+	It("should omit the VPA of the collector when all resources are targeted by the horizontal pod autoscaler", func() {
+		vpas := a.getVPAs(namespace, config.CollectorScalingConfig{
+			Autoscaling: &config.AutoscalingConfig{
+				MaxReplicas:             3,
+				TargetCPUUtilization:    new(int32(80)),
+				TargetMemoryUtilization: new(int32(80)),
+			},
+		})
+		Expect(vpas).To(HaveLen(1))
+		Expect(vpas[0].Spec.TargetRef.Name).To(Equal(TargetAllocatorDeploymentName))
+	})
+})
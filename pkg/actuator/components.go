@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/bearertokenauthextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/exporter/debugexporter"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
+	"go.opentelemetry.io/collector/processor/batchprocessor"
+	"go.opentelemetry.io/collector/processor/memorylimiterprocessor"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver"
+)
+
+// componentFactories contains the factories of the upstream components, which
+// are rendered into the collector configuration, by the section of the
+// collector configuration.
+//
+// Components without a registered factory are rejected, unless their type is
+// listed in [unvalidatedComponentTypes]. When rendering another component, add
+// its factory to the respective section.
+var componentFactories = map[string][]component.Factory{
+	"receivers": {
+		otlpreceiver.NewFactory(),
+	},
+	"processors": {
+		batchprocessor.NewFactory(),
+		memorylimiterprocessor.NewFactory(),
+		resourceprocessor.NewFactory(),
+		filterprocessor.NewFactory(),
+		transformprocessor.NewFactory(),
+		tailsamplingprocessor.NewFactory(),
+		probabilisticsamplerprocessor.NewFactory(),
+	},
+	"exporters": {
+		otlphttpexporter.NewFactory(),
+		otlpexporter.NewFactory(),
+		kafkaexporter.NewFactory(),
+		debugexporter.NewFactory(),
+	},
+	"extensions": {
+		filestorage.NewFactory(),
+		bearertokenauthextension.NewFactory(),
+	},
+}
+
+// unvalidatedComponentTypes contains the types of the rendered components by
+// the section of the collector configuration, whose settings are not validated
+// against the upstream components, since their factories are not registered in
+// [componentFactories]. Remove a type from this list, once its factory is
+// registered.
+var unvalidatedComponentTypes = map[string][]string{
+	"receivers":  {"k8sobjects", "prometheus"},
+	"exporters":  {"prometheusremotewrite"},
+	"extensions": {"k8s_leader_elector"},
+}
+
+// configValidator is implemented by the configuration of upstream components,
+// which validate their settings.
+type configValidator interface {
+	Validate() error
+}
+
+// ValidateComponentConfigs validates the settings of the receivers,
+// processors, exporters, connectors and extensions in the given collector
+// configuration against the configuration of the respective upstream
+// components.
+//
+// The settings of each component are unmarshaled into the configuration of the
+// upstream component, which fails for unknown or mistyped keys, and the
+// resulting configuration is validated. Components, which are not known to
+// the extension, are rejected, except for the ones listed in
+// [unvalidatedComponentTypes].
+func ValidateComponentConfigs(cfg otelv1beta1.Config) error {
+	sections := map[string]map[string]any{
+		"receivers": cfg.Receivers.Object,
+		"exporters": cfg.Exporters.Object,
+	}
+
+	if cfg.Processors != nil {
+		sections["processors"] = cfg.Processors.Object
+	}
+
+	if cfg.Connectors != nil {
+		sections["connectors"] = cfg.Connectors.Object
+	}
+
+	if cfg.Extensions != nil {
+		sections["extensions"] = cfg.Extensions.Object
+	}
+
+	errs := make([]error, 0)
+	for _, section := range slices.Sorted(maps.Keys(sections)) {
+		components := sections[section]
+		for _, id := range slices.Sorted(maps.Keys(components)) {
+			if err := validateComponentConfig(section, id, components[id]); err != nil {
+				errs = append(errs, fmt.Errorf("invalid settings of %s %s: %w", section, id, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateComponentConfig validates the settings of the component with the
+// given id in the given section of the collector configuration.
+func validateComponentConfig(section, id string, settings any) error {
+	// The id of a component is either its type or its type and name
+	// separated by a slash, e.g. filter/logs.
+	typ, _, _ := strings.Cut(id, "/")
+
+	factories := componentFactories[section]
+	idx := slices.IndexFunc(factories, func(f component.Factory) bool {
+		return f.Type().String() == typ
+	})
+	if idx == -1 {
+		if slices.Contains(unvalidatedComponentTypes[section], typ) {
+			return nil
+		}
+
+		return fmt.Errorf("unknown component type %q", typ)
+	}
+
+	var data map[string]any
+	if settings != nil {
+		m, ok := settings.(map[string]any)
+		if !ok {
+			return fmt.Errorf("invalid settings of type %T", settings)
+		}
+		data = m
+	}
+
+	cfg := factories[idx].CreateDefaultConfig()
+	if err := confmap.NewFromStringMap(data).Unmarshal(cfg); err != nil {
+		return err
+	}
+
+	if v, ok := cfg.(configValidator); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"errors"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/validation"
+	"github.com/gardener/gardener-extension-otelcol/pkg/imagevector"
+)
+
+// RenderedObjects contains the objects, which the [Actuator] deploys for a
+// provider configuration.
+type RenderedObjects struct {
+	// Seed contains the objects, which are deployed into the shoot namespace
+	// of the seed cluster.
+	Seed []client.Object
+	// Shoot contains the objects, which are deployed into the shoot cluster.
+	Shoot []client.Object
+}
+
+// Render returns the objects, which [Actuator.Reconcile] deploys for the given
+// provider configuration into the given namespace, without accessing any
+// cluster.
+//
+// The secrets, which are usually generated by the secrets manager, are
+// stubbed with secrets of a well-known name, and the checksums of the
+// referenced secrets are not added to the collector pods. The referenced
+// resources must be secrets in the resources of the shoot, in the same way as
+// during reconciliation.
+func (a *Actuator) Render(namespace string, cfg config.CollectorConfig, cluster *extensionscontroller.Cluster) (*RenderedObjects, error) {
+	if cluster == nil || cluster.Shoot == nil {
+		return nil, errors.New("no shoot specified")
+	}
+
+	if err := validation.Validate(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := validateReferencedResources(cfg, cluster.Shoot.Spec.Resources); err != nil {
+		return nil, err
+	}
+
+	secrets := renderSecrets{
+		caBundle:    newStubSecret(namespace, secretNameCACertificate+"-bundle"),
+		server:      newStubSecret(namespace, secretNameServerCertificate),
+		client:      newStubSecret(namespace, secretNameClientCertificate),
+		shootAccess: gardenerutils.NewShootAccessSecret(shootAccessSecretName, namespace),
+	}
+
+	result, err := a.renderObjects(namespace, cfg, cluster, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.RenderedObjects, nil
+}
+
+// validateReferencedResources validates that the resources referenced by the
+// given provider configuration are secrets in the given resources of the
+// shoot, so that the references can be resolved to the secrets in the seed.
+func validateReferencedResources(cfg config.CollectorConfig, resources []gardencorev1beta1.NamedResourceReference) error {
+	allErrs := make(field.ErrorList, 0)
+	for _, r := range validation.ReferencedResources(cfg) {
+		if secretNameForResource(r.Ref.ResourceRef.Name, resources) == "" {
+			allErrs = append(
+				allErrs,
+				field.Invalid(r.Path.Child("resourceRef", "name"), r.Ref.ResourceRef.Name, "resource is not a v1/Secret in .spec.resources of the shoot"),
+			)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// renderSecrets contains the secrets, which are referenced by the rendered
+// objects.
+type renderSecrets struct {
+	// caBundle is the CA bundle of the Target Allocator certificates.
+	caBundle *corev1.Secret
+	// server is the server certificate of the Target Allocator.
+	server *corev1.Secret
+	// client is the client certificate of the collector for the Target
+	// Allocator.
+	client *corev1.Secret
+	// shootAccess is the access secret of the collector for the shoot
+	// cluster.
+	shootAccess *gardenerutils.AccessSecret
+	// referencedChecksums contains the checksums of the referenced secrets,
+	// which are added to the annotations of the collector pods.
+	referencedChecksums map[string]string
+}
+
+// renderResult contains the rendered objects along with the collector and the
+// images they have been rendered with.
+type renderResult struct {
+	RenderedObjects
+
+	collector      *otelv1beta1.OpenTelemetryCollector
+	collectorImage *imagevectorutils.Image
+	taImage        *imagevectorutils.Image
+}
+
+// renderObjects assembles the seed and shoot objects for the given provider
+// configuration and secrets. Both [Actuator.Reconcile] and [Actuator.Render]
+// use it, so that the rendered objects match the deployed ones. The provider
+// configuration is expected to be validated already.
+func (a *Actuator) renderObjects(
+	namespace string,
+	cfg config.CollectorConfig,
+	cluster *extensionscontroller.Cluster,
+	secrets renderSecrets,
+) (*renderResult, error) {
+	taImage, err := imagevector.Images().FindImage(imagevector.ImageNameOTelTargetAllocator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find image: %w", err)
+	}
+
+	collectorImage, err := imagevector.Images().FindImage(imagevector.ImageNameOTelCollector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find image: %w", err)
+	}
+
+	collector := a.getOtelCollector(
+		namespace,
+		secrets.caBundle,
+		secrets.client,
+		cfg,
+		cluster,
+		extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster),
+		secrets.shootAccess.Secret.Name,
+		collectorImage,
+	)
+	collector.Spec.PodAnnotations = utils.MergeStringMaps(collector.Spec.PodAnnotations, secrets.referencedChecksums)
+
+	if err := ValidateComponentConfigs(collector.Spec.Config); err != nil {
+		return nil, fmt.Errorf("invalid collector configuration: %w", err)
+	}
+
+	seedObjects, err := a.getSeedObjects(namespace, collector, secrets.caBundle, secrets.server, taImage, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The collector and the Target Allocator are scaled down while the shoot
+	// is hibernated, and get the replicas from the provider config again
+	// once the shoot is woken up.
+	if v1beta1helper.HibernationIsEnabled(cluster.Shoot) {
+		scaleDownForHibernation(seedObjects)
+	}
+
+	result := &renderResult{
+		RenderedObjects: RenderedObjects{
+			Seed:  seedObjects,
+			Shoot: a.getShootObjects(secrets.shootAccess.ServiceAccountName, cfg),
+		},
+		collector:      collector,
+		collectorImage: collectorImage,
+		taImage:        taImage,
+	}
+
+	return result, nil
+}
+
+// newStubSecret returns a [corev1.Secret] with the given name, which stands in
+// for a secret generated by the secrets manager.
+func newStubSecret(namespace, name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+}
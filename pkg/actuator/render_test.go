@@ -0,0 +1,609 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator_test
+
+import (
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"go.yaml.in/yaml/v4"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+var _ = Describe("Render", func() {
+	const namespace = "shoot--local--local"
+
+	var (
+		act     *actuator.Actuator
+		cfg     config.CollectorConfig
+		cluster *extensionscontroller.Cluster
+	)
+
+	BeforeEach(func() {
+		var err error
+		act, err = actuator.New(k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg = config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					DebugExporter: config.DebugExporterConfig{
+						Enabled:   new(true),
+						Verbosity: config.DebugExporterVerbosityNormal,
+					},
+				},
+			},
+		}
+
+		cluster = &extensionscontroller.Cluster{
+			Shoot: &corev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      localName,
+					Namespace: "garden-local",
+				},
+			},
+		}
+	})
+
+	It("should render the objects deployed into the seed and shoot clusters", func() {
+		rendered, err := act.Render(namespace, cfg, cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(rendered.Seed).To(ContainElement(BeAssignableToTypeOf(&otelv1beta1.OpenTelemetryCollector{})))
+		Expect(rendered.Seed).To(ContainElement(BeAssignableToTypeOf(&appsv1.Deployment{})))
+		for _, obj := range rendered.Seed {
+			Expect(obj.GetNamespace()).To(Equal(namespace))
+		}
+		Expect(rendered.Shoot).To(HaveLen(2))
+
+		var deployment *appsv1.Deployment
+		for _, obj := range rendered.Seed {
+			if d, ok := obj.(*appsv1.Deployment); ok {
+				deployment = d
+			}
+		}
+		Expect(deployment.Name).To(Equal(actuator.TargetAllocatorDeploymentName))
+		Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(
+			HaveField("VolumeSource.Secret.SecretName", "ca-otelcol-bundle"),
+		))
+	})
+
+	It("should render the same objects on each call", func() {
+		first, err := act.Render(namespace, cfg, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		second, err := act.Render(namespace, cfg, cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(second.Seed).To(Equal(first.Seed))
+		Expect(second.Shoot).To(Equal(first.Shoot))
+	})
+
+	Context("scaling", func() {
+		getCollector := func(rendered *actuator.RenderedObjects) *otelv1beta1.OpenTelemetryCollector {
+			for _, obj := range rendered.Seed {
+				if c, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok {
+					return c
+				}
+			}
+
+			return nil
+		}
+
+		getDeployment := func(rendered *actuator.RenderedObjects) *appsv1.Deployment {
+			for _, obj := range rendered.Seed {
+				if d, ok := obj.(*appsv1.Deployment); ok {
+					return d
+				}
+			}
+
+			return nil
+		}
+
+		It("should render a single replica by default", func() {
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			collector := getCollector(rendered)
+			Expect(collector.Spec.Replicas).To(Equal(new(int32(1))))
+			Expect(collector.Spec.Autoscaler).To(BeNil())
+			Expect(collector.Spec.PodDisruptionBudget).To(BeNil())
+			Expect(collector.Spec.Config.Extensions).To(BeNil())
+			Expect(collector.Spec.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceCPU, resource.MustParse("10m")))
+
+			Expect(getDeployment(rendered).Spec.Replicas).To(Equal(new(int32(1))))
+			Expect(rendered.Seed).NotTo(ContainElement(BeAssignableToTypeOf(&policyv1.PodDisruptionBudget{})))
+			Expect(rendered.Seed).To(ContainElement(BeAssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})))
+			Expect(rendered.Shoot).To(HaveLen(2))
+		})
+
+		It("should render the configured replicas and resources", func() {
+			resources := &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("200Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("400Mi")},
+			}
+			cfg.Spec.Scaling = config.ScalingConfig{
+				Collector: config.CollectorScalingConfig{
+					Replicas:  new(int32(3)),
+					Resources: resources,
+				},
+				TargetAllocator: config.TargetAllocatorScalingConfig{
+					Replicas:  new(int32(2)),
+					Resources: resources,
+				},
+			}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			collector := getCollector(rendered)
+			Expect(collector.Spec.Replicas).To(Equal(new(int32(3))))
+			Expect(collector.Spec.Resources).To(Equal(*resources))
+			Expect(collector.Spec.PodDisruptionBudget).NotTo(BeNil())
+			Expect(collector.Spec.Config.Extensions.Object).To(HaveKey("k8s_leader_elector"))
+			Expect(collector.Spec.Config.Service.Extensions).To(ContainElement("k8s_leader_elector"))
+			Expect(collector.Spec.Config.Receivers.Object["k8sobjects/events"]).To(HaveKeyWithValue("k8s_leader_elector", "k8s_leader_elector"))
+
+			deployment := getDeployment(rendered)
+			Expect(deployment.Spec.Replicas).To(Equal(new(int32(2))))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources).To(Equal(*resources))
+			Expect(rendered.Seed).To(ContainElement(And(
+				BeAssignableToTypeOf(&policyv1.PodDisruptionBudget{}),
+				HaveField("Spec.Selector.MatchLabels", deployment.Spec.Selector.MatchLabels),
+			)))
+
+			Expect(rendered.Shoot).To(HaveLen(4))
+			Expect(rendered.Shoot).To(ContainElement(And(
+				BeAssignableToTypeOf(&rbacv1.Role{}),
+				HaveField("ObjectMeta.Namespace", metav1.NamespaceSystem),
+			)))
+		})
+
+		It("should render the autoscaler of the collector", func() {
+			cfg.Spec.Scaling.Collector.Autoscaling = &config.AutoscalingConfig{
+				MinReplicas: new(int32(2)),
+				MaxReplicas: 5,
+			}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			collector := getCollector(rendered)
+			Expect(collector.Spec.Replicas).To(Equal(new(int32(2))))
+			Expect(collector.Spec.Autoscaler).To(Equal(&otelv1beta1.AutoscalerSpec{
+				MinReplicas:          new(int32(2)),
+				MaxReplicas:          new(int32(5)),
+				TargetCPUUtilization: new(int32(80)),
+			}))
+			Expect(collector.Spec.PodDisruptionBudget).NotTo(BeNil())
+		})
+	})
+
+	Context("kubernetes objects", func() {
+		getReceiverObjects := func(rendered *actuator.RenderedObjects) []any {
+			for _, obj := range rendered.Seed {
+				if c, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok {
+					return c.Spec.Config.Receivers.Object["k8sobjects/events"].(map[string]any)["objects"].([]any)
+				}
+			}
+
+			return nil
+		}
+
+		It("should watch the events of the events.k8s.io API group by default", func() {
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getReceiverObjects(rendered)).To(Equal([]any{
+				map[string]any{"name": "events", "group": "events.k8s.io", "mode": "watch"},
+			}))
+			Expect(rendered.Shoot).To(ContainElement(And(
+				BeAssignableToTypeOf(&rbacv1.ClusterRole{}),
+				HaveField("Rules", []rbacv1.PolicyRule{{
+					APIGroups: []string{"events.k8s.io"},
+					Resources: []string{"events"},
+					Verbs:     []string{"list", "watch"},
+				}}),
+			)))
+		})
+
+		It("should render the configured objects with least-privilege RBAC", func() {
+			cfg.Spec.KubernetesObjects = []config.KubernetesObjectConfig{
+				{
+					Name: "nodes",
+					Mode: config.KubernetesObjectModePull,
+				},
+				{
+					Name:          "pods",
+					Mode:          config.KubernetesObjectModeWatch,
+					Namespaces:    []string{metav1.NamespaceSystem},
+					LabelSelector: "gardener.cloud/role=system-component",
+				},
+				{
+					Name:          "leases",
+					Group:         "coordination.k8s.io",
+					Mode:          config.KubernetesObjectModePull,
+					Interval:      10 * time.Minute,
+					Namespaces:    []string{metav1.NamespaceSystem},
+					FieldSelector: "metadata.name!=kube-scheduler",
+				},
+			}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getReceiverObjects(rendered)).To(Equal([]any{
+				map[string]any{"name": "nodes", "mode": "pull", "interval": "1h0m0s"},
+				map[string]any{
+					"name":           "pods",
+					"mode":           "watch",
+					"namespaces":     []string{metav1.NamespaceSystem},
+					"label_selector": "gardener.cloud/role=system-component",
+				},
+				map[string]any{
+					"name":           "leases",
+					"group":          "coordination.k8s.io",
+					"mode":           "pull",
+					"interval":       "10m0s",
+					"namespaces":     []string{metav1.NamespaceSystem},
+					"field_selector": "metadata.name!=kube-scheduler",
+				},
+			}))
+
+			Expect(rendered.Shoot).To(HaveLen(4))
+			Expect(rendered.Shoot).To(ContainElement(And(
+				BeAssignableToTypeOf(&rbacv1.ClusterRole{}),
+				HaveField("Rules", []rbacv1.PolicyRule{{
+					APIGroups: []string{""},
+					Resources: []string{"nodes"},
+					Verbs:     []string{"list"},
+				}}),
+			)))
+			Expect(rendered.Shoot).To(ContainElement(And(
+				BeAssignableToTypeOf(&rbacv1.Role{}),
+				HaveField("ObjectMeta.Namespace", metav1.NamespaceSystem),
+				HaveField("Rules", []rbacv1.PolicyRule{
+					{
+						APIGroups: []string{""},
+						Resources: []string{"pods"},
+						Verbs:     []string{"list", "watch"},
+					},
+					{
+						APIGroups: []string{"coordination.k8s.io"},
+						Resources: []string{"leases"},
+						Verbs:     []string{"list"},
+					},
+				}),
+			)))
+			Expect(rendered.Shoot).To(ContainElement(And(
+				BeAssignableToTypeOf(&rbacv1.RoleBinding{}),
+				HaveField("ObjectMeta.Namespace", metav1.NamespaceSystem),
+			)))
+		})
+
+		It("should render the events filters", func() {
+			cfg.Spec.Events = config.EventsConfig{
+				Namespaces:     []string{metav1.NamespaceSystem},
+				Types:          []config.EventType{config.EventTypeWarning},
+				ExcludeReasons: []string{"Pulled"},
+			}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getReceiverObjects(rendered)).To(Equal([]any{
+				map[string]any{
+					"name":       "events",
+					"group":      "events.k8s.io",
+					"mode":       "watch",
+					"namespaces": []string{metav1.NamespaceSystem},
+				},
+			}))
+
+			for _, obj := range rendered.Seed {
+				if c, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok {
+					Expect(c.Spec.Config.Processors.Object).To(HaveKey("filter/events"))
+					Expect(c.Spec.Config.Service.Pipelines["logs/events"].Processors).To(ContainElements("filter/events", "transform/events"))
+				}
+			}
+
+			Expect(rendered.Shoot).To(HaveLen(2))
+			Expect(rendered.Shoot).To(ContainElement(And(
+				BeAssignableToTypeOf(&rbacv1.Role{}),
+				HaveField("ObjectMeta.Namespace", metav1.NamespaceSystem),
+			)))
+		})
+
+		It("should not render a ClusterRole, when all objects are namespaced", func() {
+			cfg.Spec.KubernetesObjects = []config.KubernetesObjectConfig{{
+				Name:       "events",
+				Mode:       config.KubernetesObjectModeWatch,
+				Namespaces: []string{metav1.NamespaceSystem, metav1.NamespaceDefault},
+			}}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rendered.Shoot).To(HaveLen(4))
+			Expect(rendered.Shoot).NotTo(ContainElement(BeAssignableToTypeOf(&rbacv1.ClusterRole{})))
+		})
+	})
+
+	Context("metrics scrape", func() {
+		getTargetAllocatorConfig := func(rendered *actuator.RenderedObjects) map[string]any {
+			for _, obj := range rendered.Seed {
+				if cm, ok := obj.(*corev1.ConfigMap); ok {
+					var taConfig map[string]any
+					Expect(yaml.Unmarshal([]byte(cm.Data["targetallocator.yaml"]), &taConfig)).To(Succeed())
+
+					return taConfig
+				}
+			}
+
+			return nil
+		}
+
+		It("should select the ServiceMonitors of the shoot Prometheus by default", func() {
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			taConfig := getTargetAllocatorConfig(rendered)
+			Expect(taConfig).To(HaveKeyWithValue("allocation_strategy", "consistent-hashing"))
+			Expect(taConfig["prometheus_cr"]).To(And(
+				HaveKeyWithValue("scrape_interval", "30s"),
+				HaveKeyWithValue("service_monitor_selector", map[string]any{
+					"matchLabels": map[string]any{"prometheus": "shoot"},
+				}),
+				HaveKeyWithValue("pod_monitor_selector", BeNil()),
+				HaveKeyWithValue("scrape_config_selector", BeNil()),
+			))
+		})
+
+		It("should render the configured scrape settings", func() {
+			cfg.Spec.Metrics.Scrape = config.MetricsScrapeConfig{
+				Interval:           time.Minute,
+				AllocationStrategy: config.AllocationStrategyLeastWeighted,
+				ServiceMonitorSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "prometheus",
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{"shoot", "cache"},
+					}},
+				},
+				PodMonitorSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"prometheus": "aggregate"},
+				},
+			}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			taConfig := getTargetAllocatorConfig(rendered)
+			Expect(taConfig).To(HaveKeyWithValue("allocation_strategy", "least-weighted"))
+			Expect(taConfig["prometheus_cr"]).To(And(
+				HaveKeyWithValue("scrape_interval", "1m0s"),
+				HaveKeyWithValue("service_monitor_selector", map[string]any{
+					"matchExpressions": []any{map[string]any{
+						"key":      "prometheus",
+						"operator": "In",
+						"values":   []any{"shoot", "cache"},
+					}},
+				}),
+				HaveKeyWithValue("pod_monitor_selector", map[string]any{
+					"matchLabels": map[string]any{"prometheus": "aggregate"},
+				}),
+			))
+			Expect(taConfig["prometheus_cr"]).NotTo(HaveKey("scrape_classes"))
+		})
+
+		It("should inject the metric relabeling rules", func() {
+			cfg.Spec.Metrics.Scrape.MetricRelabelConfigs = []config.RelabelConfig{
+				{
+					SourceLabels: []string{"__name__"},
+					Regex:        ".+_bucket",
+					Action:       config.RelabelActionDrop,
+				},
+				{
+					SourceLabels: []string{"pod"},
+					TargetLabel:  "k8s_pod_name",
+				},
+			}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			taConfig := getTargetAllocatorConfig(rendered)
+			Expect(taConfig["prometheus_cr"]).To(HaveKeyWithValue("scrape_classes", []any{
+				map[string]any{
+					"name":    "default",
+					"default": true,
+					"metricRelabelings": []any{
+						map[string]any{
+							"action":       "drop",
+							"sourceLabels": []any{"__name__"},
+							"regex":        ".+_bucket",
+						},
+						map[string]any{
+							"action":       "replace",
+							"sourceLabels": []any{"pod"},
+							"targetLabel":  "k8s_pod_name",
+						},
+					},
+				},
+			}))
+
+			var collector *otelv1beta1.OpenTelemetryCollector
+			for _, obj := range rendered.Seed {
+				if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok {
+					collector = o
+				}
+			}
+			Expect(collector).NotTo(BeNil())
+
+			receiver := collector.Spec.Config.Receivers.Object["prometheus"].(map[string]any)
+			scrapeJobs := receiver["config"].(map[string]any)["scrape_configs"].([]any)
+			Expect(scrapeJobs).To(HaveLen(1))
+			Expect(scrapeJobs[0]).To(HaveKeyWithValue("metric_relabel_configs", []any{
+				map[string]any{
+					"action":        "drop",
+					"source_labels": []string{"__name__"},
+					"regex":         ".+_bucket",
+				},
+				map[string]any{
+					"action":        "replace",
+					"source_labels": []string{"pod"},
+					"target_label":  "k8s_pod_name",
+				},
+			}))
+		})
+
+		It("should render scrape classes, which decode into the Prometheus Operator types", func() {
+			cfg.Spec.Metrics.Scrape.MetricRelabelConfigs = []config.RelabelConfig{
+				{
+					SourceLabels: []string{"__name__"},
+					Regex:        ".+_bucket",
+					Action:       config.RelabelActionDrop,
+				},
+				{
+					SourceLabels: []string{"pod"},
+					Separator:    new("/"),
+					TargetLabel:  "k8s_pod_name",
+					Replacement:  new("${1}"),
+				},
+			}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			// The Target Allocator reads the scrape classes as
+			// monitoringv1.ScrapeClass, hence the rendered keys must match
+			// its fields exactly.
+			data, err := yaml.Marshal(getTargetAllocatorConfig(rendered)["prometheus_cr"].(map[string]any)["scrape_classes"])
+			Expect(err).NotTo(HaveOccurred())
+
+			var scrapeClasses []monitoringv1.ScrapeClass
+			Expect(sigsyaml.UnmarshalStrict(data, &scrapeClasses)).To(Succeed())
+			Expect(scrapeClasses).To(Equal([]monitoringv1.ScrapeClass{{
+				Name:    "default",
+				Default: new(true),
+				MetricRelabelings: []monitoringv1.RelabelConfig{
+					{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Regex:        ".+_bucket",
+						Action:       "drop",
+					},
+					{
+						SourceLabels: []monitoringv1.LabelName{"pod"},
+						Separator:    new("/"),
+						TargetLabel:  "k8s_pod_name",
+						Replacement:  new("${1}"),
+						Action:       "replace",
+					},
+				},
+			}}))
+		})
+	})
+
+	It("should scale the collector and the Target Allocator down for a hibernated shoot", func() {
+		cfg.Spec.Scaling.Collector.Autoscaling = &config.AutoscalingConfig{MaxReplicas: 3}
+		cluster.Shoot.Spec.Hibernation = &corev1beta1.Hibernation{Enabled: new(true)}
+
+		rendered, err := act.Render(namespace, cfg, cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, obj := range rendered.Seed {
+			switch o := obj.(type) {
+			case *appsv1.Deployment:
+				Expect(o.Spec.Replicas).To(Equal(new(int32(0))))
+			case *otelv1beta1.OpenTelemetryCollector:
+				Expect(o.Spec.Replicas).To(Equal(new(int32(0))))
+				Expect(o.Spec.Autoscaler).To(BeNil())
+			}
+		}
+	})
+
+	Context("referenced resources", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org:4318",
+				Encoding: config.MessageEncodingProto,
+				Token: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "otelcol-token", DataKey: "token"},
+				},
+			}
+		})
+
+		It("should resolve the references to the secrets of the shoot resources", func() {
+			cluster.Shoot.Spec.Resources = []corev1beta1.NamedResourceReference{{
+				Name: "otelcol-token",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Name:       "my-otelcol-token",
+				},
+			}}
+
+			rendered, err := act.Render(namespace, cfg, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			var collector *otelv1beta1.OpenTelemetryCollector
+			for _, obj := range rendered.Seed {
+				if c, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok {
+					collector = c
+				}
+			}
+			Expect(collector).NotTo(BeNil())
+			Expect(collector.Spec.Volumes).To(ContainElement(And(
+				HaveField("Name", "bearer-token-auth-exporter-otlp-http"),
+				HaveField("VolumeSource.Secret.SecretName", "ref-my-otelcol-token"),
+			)))
+		})
+
+		It("should fail when a referenced resource is not a secret of the shoot", func() {
+			_, err := act.Render(namespace, cfg, cluster)
+			Expect(err).To(MatchError(And(
+				ContainSubstring("spec.exporters.otlp_http.token.resourceRef.name"),
+				ContainSubstring("resource is not a v1/Secret in .spec.resources of the shoot"),
+			)))
+
+			cluster.Shoot.Spec.Resources = []corev1beta1.NamedResourceReference{{
+				Name: "otelcol-token",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       "my-otelcol-token",
+				},
+			}}
+			_, err = act.Render(namespace, cfg, cluster)
+			Expect(err).To(MatchError(ContainSubstring("resource is not a v1/Secret")))
+		})
+	})
+
+	It("should fail without a shoot", func() {
+		_, err := act.Render(namespace, cfg, &extensionscontroller.Cluster{})
+		Expect(err).To(MatchError("no shoot specified"))
+	})
+
+	It("should fail for an invalid provider config", func() {
+		_, err := act.Render(namespace, config.CollectorConfig{}, cluster)
+		Expect(err).To(HaveOccurred())
+	})
+})
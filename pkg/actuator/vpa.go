@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+const (
+	// otelCollectorContainerName is the name of the OTel Collector container,
+	// as created by the OpenTelemetry Operator.
+	otelCollectorContainerName = "otc-container"
+	// targetAllocatorContainerName is the name of the Target Allocator
+	// container.
+	targetAllocatorContainerName = "ta-container"
+)
+
+// VPAConfig provides the settings of the VerticalPodAutoscalers for the OTel
+// Collector and the Target Allocator.
+type VPAConfig struct {
+	// Enabled specifies whether the VerticalPodAutoscalers are deployed.
+	Enabled bool
+
+	// MinAllowed specifies the lower bounds of the recommended requests.
+	MinAllowed corev1.ResourceList
+
+	// MaxAllowed specifies the upper bounds of the recommended requests.
+	MaxAllowed corev1.ResourceList
+
+	// ControlledResources specifies the resources, whose requests are
+	// controlled by the VerticalPodAutoscalers.
+	ControlledResources []corev1.ResourceName
+}
+
+// Validate validates the [VPAConfig].
+func (c *VPAConfig) Validate() error {
+	supportedResources := []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+	if c.Enabled && len(c.ControlledResources) == 0 {
+		return errors.New("no controlled resources specified")
+	}
+
+	for _, name := range c.ControlledResources {
+		if !slices.Contains(supportedResources, name) {
+			return fmt.Errorf("unsupported controlled resource %s", name)
+		}
+	}
+
+	for name, minAllowed := range c.MinAllowed {
+		if !slices.Contains(supportedResources, name) {
+			return fmt.Errorf("unsupported min allowed resource %s", name)
+		}
+
+		if maxAllowed, ok := c.MaxAllowed[name]; ok && maxAllowed.Cmp(minAllowed) < 0 {
+			return fmt.Errorf("max allowed %s is lower than the min allowed %s", name, name)
+		}
+	}
+
+	for name := range c.MaxAllowed {
+		if !slices.Contains(supportedResources, name) {
+			return fmt.Errorf("unsupported max allowed resource %s", name)
+		}
+	}
+
+	return nil
+}
+
+// getVPAs returns the [vpaautoscalingv1.VerticalPodAutoscaler] objects for
+// the OTel Collector and the Target Allocator.
+//
+// The resources, which are targeted by the horizontal pod autoscaler of the
+// OTel Collector, are not controlled by its VerticalPodAutoscaler, so that
+// both autoscalers do not act on the same metric.
+func (a *Actuator) getVPAs(namespace string, collectorScaling config.CollectorScalingConfig) []*vpaautoscalingv1.VerticalPodAutoscaler {
+	if !a.vpaConfig.Enabled {
+		return nil
+	}
+
+	vpas := []*vpaautoscalingv1.VerticalPodAutoscaler{
+		a.getVPA(
+			namespace,
+			TargetAllocatorDeploymentName,
+			"Deployment",
+			targetAllocatorContainerName,
+			a.vpaConfig.ControlledResources,
+		),
+	}
+
+	collectorResources := slices.Clone(a.vpaConfig.ControlledResources)
+	if autoscaling := collectorScaling.Autoscaling; autoscaling != nil {
+		collectorResources = slices.DeleteFunc(collectorResources, func(name corev1.ResourceName) bool {
+			switch name {
+			case corev1.ResourceCPU:
+				return autoscaling.TargetCPUUtilization != nil || autoscaling.TargetMemoryUtilization == nil
+			case corev1.ResourceMemory:
+				return autoscaling.TargetMemoryUtilization != nil
+			}
+
+			return false
+		})
+	}
+
+	if len(collectorResources) > 0 {
+		vpas = append(vpas, a.getVPA(
+			namespace,
+			CollectorStatefulSetName,
+			"StatefulSet",
+			otelCollectorContainerName,
+			collectorResources,
+		))
+	}
+
+	return vpas
+}
+
+// getVPA returns the [vpaautoscalingv1.VerticalPodAutoscaler] for the
+// container with the given name of the workload with the given name and kind.
+func (a *Actuator) getVPA(
+	namespace string,
+	targetName string,
+	targetKind string,
+	containerName string,
+	controlledResources []corev1.ResourceName,
+) *vpaautoscalingv1.VerticalPodAutoscaler {
+	return &vpaautoscalingv1.VerticalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      targetName,
+			Namespace: namespace,
+			Labels:    a.getCommonLabels(),
+		},
+		Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+			TargetRef: &autoscalingv1.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       targetKind,
+				Name:       targetName,
+			},
+			UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{
+				UpdateMode: new(vpaautoscalingv1.UpdateModeRecreate),
+			},
+			ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+				ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
+					{
+						ContainerName:       containerName,
+						MinAllowed:          a.vpaConfig.MinAllowed.DeepCopy(),
+						MaxAllowed:          a.vpaConfig.MaxAllowed.DeepCopy(),
+						ControlledResources: new(slices.Clone(controlledResources)),
+						ControlledValues:    new(vpaautoscalingv1.ContainerControlledValuesRequestsOnly),
+					},
+					{
+						ContainerName: vpaautoscalingv1.DefaultContainerResourcePolicy,
+						Mode:          new(vpaautoscalingv1.ContainerScalingModeOff),
+					},
+				},
+			},
+		},
+	}
+}
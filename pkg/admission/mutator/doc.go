@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mutator provides mutating webhooks which implement the
+// [Gardener Extension Webhook Mutator] interface.
+//
+// [Gardener Extension Webhook Mutator]: https://github.com/gardener/gardener/blob/527d009474638b519f00bb4c7893bfd8508c013e/extensions/pkg/webhook/webhook.go#L71-L76
+
+package mutator
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/v1alpha1"
+)
+
+// shootMutator is an implementation of [extensionswebhook.Mutator], which
+// defaults and normalizes the provider configuration of the extension from a
+// [core.Shoot] spec.
+type shootMutator struct {
+	decoder       runtime.Decoder
+	extensionType string
+}
+
+var _ extensionswebhook.Mutator = &shootMutator{}
+
+// newShootMutator returns a new [shootMutator], which implements the
+// [extensionswebhook.Mutator] interface. The given [runtime.Decoder] must
+// decode the provider configuration into the versioned
+// [v1alpha1.CollectorConfig] without converting it.
+func newShootMutator(decoder runtime.Decoder) (*shootMutator, error) {
+	mutator := &shootMutator{
+		decoder:       decoder,
+		extensionType: actuator.ExtensionType,
+	}
+
+	if decoder == nil {
+		return nil, fmt.Errorf("invalid decoder specified for shoot mutator %s", mutator.extensionType)
+	}
+
+	return mutator, nil
+}
+
+// NewShootMutator returns a new [extensionswebhook.Mutator] for [core.Shoot]
+// objects.
+func NewShootMutator(decoder runtime.Decoder) (extensionswebhook.Mutator, error) {
+	return newShootMutator(decoder)
+}
+
+// Mutate implements the [extensionswebhook.Mutator] interface.
+func (m *shootMutator) Mutate(_ context.Context, newObj, _ client.Object) error {
+	shoot, ok := newObj.(*core.Shoot)
+	if !ok {
+		return fmt.Errorf("invalid object type: %T", newObj)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	idx := slices.IndexFunc(shoot.Spec.Extensions, func(ext core.Extension) bool {
+		return ext.Type == m.extensionType
+	})
+
+	// Extension is not configured, nothing to mutate
+	if idx == -1 {
+		return nil
+	}
+
+	// Extension is disabled, nothing to mutate
+	ext := shoot.Spec.Extensions[idx]
+	if ext.Disabled != nil && *ext.Disabled {
+		return nil
+	}
+
+	// A missing provider config is reported by the validator
+	if ext.ProviderConfig == nil || len(ext.ProviderConfig.Raw) == 0 {
+		return nil
+	}
+
+	var cfg v1alpha1.CollectorConfig
+	if err := runtime.DecodeInto(m.decoder, ext.ProviderConfig.Raw, &cfg); err != nil {
+		return fmt.Errorf("invalid provider spec configuration for %s: %w", m.extensionType, err)
+	}
+
+	v1alpha1.SetObjectDefaults_CollectorConfig(&cfg)
+	normalizeEndpoints(&cfg)
+	cfg.SetGroupVersionKind(schema.GroupVersion(v1alpha1.GroupVersion).WithKind("CollectorConfig"))
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to encode provider spec configuration for %s: %w", m.extensionType, err)
+	}
+
+	shoot.Spec.Extensions[idx].ProviderConfig = &runtime.RawExtension{Raw: data}
+
+	return nil
+}
+
+// normalizeEndpoints removes surrounding whitespace from the endpoints of the
+// exporters and trailing slashes from the OTLP HTTP base URLs, to which the
+// collector appends the signal specific paths.
+func normalizeEndpoints(cfg *v1alpha1.CollectorConfig) {
+	exporters := &cfg.Spec.Exporters
+
+	exporters.OTLPGRPCExporter.Endpoint = strings.TrimSpace(exporters.OTLPGRPCExporter.Endpoint)
+	normalizeOTLPHTTPEndpoints(&exporters.OTLPHTTPExporter)
+	exporters.PrometheusRemoteWriteExporter.Endpoint = strings.TrimSpace(exporters.PrometheusRemoteWriteExporter.Endpoint)
+
+	for i, broker := range exporters.KafkaExporter.Brokers {
+		exporters.KafkaExporter.Brokers[i] = strings.TrimSpace(broker)
+	}
+
+	for i := range exporters.NamedOTLPGRPCExporters {
+		exp := &exporters.NamedOTLPGRPCExporters[i]
+		exp.Endpoint = strings.TrimSpace(exp.Endpoint)
+	}
+
+	for i := range exporters.NamedOTLPHTTPExporters {
+		normalizeOTLPHTTPEndpoints(&exporters.NamedOTLPHTTPExporters[i].OTLPHTTPExporterConfig)
+	}
+}
+
+// normalizeOTLPHTTPEndpoints normalizes the endpoints of the given OTLP HTTP
+// exporter.
+func normalizeOTLPHTTPEndpoints(exp *v1alpha1.OTLPHTTPExporterConfig) {
+	exp.Endpoint = strings.TrimRight(strings.TrimSpace(exp.Endpoint), "/")
+	exp.TracesEndpoint = strings.TrimSpace(exp.TracesEndpoint)
+	exp.MetricsEndpoint = strings.TrimSpace(exp.MetricsEndpoint)
+	exp.LogsEndpoint = strings.TrimSpace(exp.LogsEndpoint)
+	exp.ProfilesEndpoint = strings.TrimSpace(exp.ProfilesEndpoint)
+}
+
+// NewShootMutatorWebhook returns a new mutating [extensionswebhook.Webhook]
+// for [core.Shoot] objects.
+func NewShootMutatorWebhook(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	decoder := serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDeserializer()
+	mutator, err := newShootMutator(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("mutator.%s", mutator.extensionType)
+	extensionLabel := fmt.Sprintf("%s%s", v1beta1constants.LabelExtensionExtensionTypePrefix, mutator.extensionType)
+	path := fmt.Sprintf("/webhooks/mutate/%s", mutator.extensionType)
+
+	logger := mgr.GetLogger()
+	logger.Info("setting up webhook", "name", name, "path", path, "label", extensionLabel)
+
+	args := extensionswebhook.Args{
+		Name: name,
+		Path: path,
+		Mutators: map[extensionswebhook.Mutator][]extensionswebhook.Type{
+			mutator: {{Obj: &core.Shoot{}}},
+		},
+		Target: extensionswebhook.TargetSeed,
+		ObjectSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				extensionLabel: "true",
+			},
+		},
+	}
+
+	return extensionswebhook.New(mgr, args)
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator_test
+
+import (
+	"context"
+	"encoding/json"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+	"github.com/gardener/gardener-extension-otelcol/pkg/admission/mutator"
+	configinstall "github.com/gardener/gardener-extension-otelcol/pkg/apis/config/install"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/v1alpha1"
+)
+
+var _ = Describe("Shoot Mutator", func() {
+	var (
+		ctx          = context.TODO()
+		scheme       *runtime.Scheme
+		decoder      runtime.Decoder
+		shootMutator extensionswebhook.Mutator
+		shoot        *core.Shoot
+	)
+
+	// withProviderConfig configures the extension in the shoot with the given
+	// raw provider config.
+	withProviderConfig := func(data string) {
+		shoot.Spec.Extensions = []core.Extension{
+			{
+				Type:           actuator.ExtensionType,
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(data)},
+			},
+		}
+	}
+
+	// getProviderConfig decodes the provider config of the extension from
+	// the shoot.
+	getProviderConfig := func() v1alpha1.CollectorConfig {
+		var cfg v1alpha1.CollectorConfig
+		Expect(json.Unmarshal(shoot.Spec.Extensions[0].ProviderConfig.Raw, &cfg)).To(Succeed())
+
+		return cfg
+	}
+
+	BeforeEach(func() {
+		var err error
+		scheme = runtime.NewScheme()
+		configinstall.Install(scheme)
+		decoder = serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDeserializer()
+		shootMutator, err = mutator.NewShootMutator(decoder)
+		Expect(err).NotTo(HaveOccurred())
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "local",
+				Namespace: "garden-local",
+			},
+		}
+	})
+
+	It("should fail to create shoot mutator with invalid decoder", func() {
+		_, err := mutator.NewShootMutator(nil)
+		Expect(err).To(MatchError(ContainSubstring("invalid decoder specified")))
+	})
+
+	It("should fail to mutate objects other than shoots", func() {
+		Expect(shootMutator.Mutate(ctx, &core.Seed{}, nil)).To(MatchError(ContainSubstring("invalid object type")))
+	})
+
+	It("should not mutate shoots without the extension", func() {
+		Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+		Expect(shoot.Spec.Extensions).To(BeEmpty())
+	})
+
+	It("should not mutate disabled extensions", func() {
+		withProviderConfig(`{"apiVersion":"otelcol.extensions.gardener.cloud/v1alpha1","kind":"CollectorConfig"}`)
+		shoot.Spec.Extensions[0].Disabled = new(true)
+
+		Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+		Expect(string(shoot.Spec.Extensions[0].ProviderConfig.Raw)).To(Equal(`{"apiVersion":"otelcol.extensions.gardener.cloud/v1alpha1","kind":"CollectorConfig"}`))
+	})
+
+	It("should not mutate extensions without provider config", func() {
+		shoot.Spec.Extensions = []core.Extension{{Type: actuator.ExtensionType}}
+
+		Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+		Expect(shoot.Spec.Extensions[0].ProviderConfig).To(BeNil())
+	})
+
+	It("should fail to mutate invalid provider config", func() {
+		withProviderConfig(`{"apiVersion":"otelcol.extensions.gardener.cloud/v1alpha1","kind":"CollectorConfig","spec":{"unknown":true}}`)
+
+		Expect(shootMutator.Mutate(ctx, shoot, nil)).To(MatchError(ContainSubstring("invalid provider spec configuration")))
+	})
+
+	It("should default the provider config", func() {
+		withProviderConfig(`{
+  "apiVersion": "otelcol.extensions.gardener.cloud/v1alpha1",
+  "kind": "CollectorConfig",
+  "spec": {
+    "exporters": {
+      "otlp_http": {
+        "enabled": true,
+        "endpoint": "https://example.com:4318"
+      }
+    }
+  }
+}`)
+
+		Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+
+		cfg := getProviderConfig()
+		Expect(cfg.APIVersion).To(Equal("otelcol.extensions.gardener.cloud/v1alpha1"))
+		Expect(cfg.Kind).To(Equal("CollectorConfig"))
+		Expect(cfg.Spec.Exporters.OTLPHTTPExporter.Compression).To(Equal(v1alpha1.CompressionGzip))
+		Expect(cfg.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.Enabled).To(Equal(new(true)))
+		Expect(cfg.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.InitialInterval).To(Equal(v1alpha1.DefaultRetryInitialInterval))
+		Expect(cfg.Spec.Exporters.OTLPGRPCExporter.Enabled).To(Equal(new(false)))
+		Expect(cfg.Spec.Exporters.OTLPGRPCExporter.Timeout).To(Equal(v1alpha1.DefaultGRPCExporterClientTimeout))
+	})
+
+	It("should normalize the endpoints", func() {
+		withProviderConfig(`{
+  "apiVersion": "otelcol.extensions.gardener.cloud/v1alpha1",
+  "kind": "CollectorConfig",
+  "spec": {
+    "exporters": {
+      "otlp_grpc": {
+        "enabled": true,
+        "endpoint": " otel.example.com:4317 "
+      },
+      "otlp_http": {
+        "enabled": true,
+        "endpoint": " https://example.com:4318/ ",
+        "traces_endpoint": " https://example.com:4318/v1/traces "
+      },
+      "prometheusremotewrite": {
+        "enabled": true,
+        "endpoint": " https://example.com/api/v1/write "
+      },
+      "kafka": {
+        "enabled": true,
+        "brokers": [" kafka-0:9092", "kafka-1:9092 "]
+      },
+      "named_otlp_grpc": [
+        {"name": "foo", "enabled": true, "endpoint": " foo.example.com:4317"}
+      ],
+      "named_otlp_http": [
+        {"name": "bar", "enabled": true, "endpoint": "https://bar.example.com//"}
+      ]
+    }
+  }
+}`)
+
+		Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+
+		exporters := getProviderConfig().Spec.Exporters
+		Expect(exporters.OTLPGRPCExporter.Endpoint).To(Equal("otel.example.com:4317"))
+		Expect(exporters.OTLPHTTPExporter.Endpoint).To(Equal("https://example.com:4318"))
+		Expect(exporters.OTLPHTTPExporter.TracesEndpoint).To(Equal("https://example.com:4318/v1/traces"))
+		Expect(exporters.PrometheusRemoteWriteExporter.Endpoint).To(Equal("https://example.com/api/v1/write"))
+		Expect(exporters.KafkaExporter.Brokers).To(Equal([]string{"kafka-0:9092", "kafka-1:9092"}))
+		Expect(exporters.NamedOTLPGRPCExporters[0].Endpoint).To(Equal("foo.example.com:4317"))
+		Expect(exporters.NamedOTLPHTTPExporters[0].Endpoint).To(Equal("https://bar.example.com"))
+	})
+
+	It("should be idempotent", func() {
+		withProviderConfig(`{"apiVersion":"otelcol.extensions.gardener.cloud/v1alpha1","kind":"CollectorConfig"}`)
+
+		Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+		data := shoot.Spec.Extensions[0].ProviderConfig.Raw
+
+		Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+		Expect(shoot.Spec.Extensions[0].ProviderConfig.Raw).To(Equal(data))
+	})
+})
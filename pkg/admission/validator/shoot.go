@@ -9,14 +9,23 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
@@ -43,16 +52,21 @@ func IgnoreExtensionNotFound(err error) error {
 // spec.
 type shootValidator struct {
 	decoder       runtime.Decoder
+	reader        client.Reader
+	actuator      *actuator.Actuator
 	extensionType string
 }
 
 var _ extensionswebhook.Validator = &shootValidator{}
 
 // newShootValidator returns a new [shootValidator], which implements the
-// [extensionswebhook.Validator] interface.
-func newShootValidator(decoder runtime.Decoder) (*shootValidator, error) {
+// [extensionswebhook.Validator] interface. The given [client.Reader] is used
+// for reading the secrets referenced by the extension from the garden
+// cluster.
+func newShootValidator(decoder runtime.Decoder, reader client.Reader) (*shootValidator, error) {
 	validator := &shootValidator{
 		decoder:       decoder,
+		reader:        reader,
 		extensionType: actuator.ExtensionType,
 	}
 
@@ -60,13 +74,54 @@ func newShootValidator(decoder runtime.Decoder) (*shootValidator, error) {
 		return nil, fmt.Errorf("invalid decoder specified for shoot validator %s", validator.extensionType)
 	}
 
+	if reader == nil {
+		return nil, fmt.Errorf("invalid reader specified for shoot validator %s", validator.extensionType)
+	}
+
+	act, err := newRenderActuator(decoder)
+	if err != nil {
+		return nil, err
+	}
+	validator.actuator = act
+
 	return validator, nil
 }
 
+// newRenderActuator returns a new [actuator.Actuator], which is only used for
+// rendering the objects of the extension. Rendering does not access any
+// cluster, so the actuator is created with an empty fake client.
+func newRenderActuator(decoder runtime.Decoder) (*actuator.Actuator, error) {
+	return actuator.New(fakeclient.NewClientBuilder().Build(), actuator.WithDecoder(decoder))
+}
+
 // NewShootValidator returns a new [extensionswebhook.Validator] for
 // [core.Shoot] objects.
-func NewShootValidator(decoder runtime.Decoder) (extensionswebhook.Validator, error) {
-	return newShootValidator(decoder)
+func NewShootValidator(decoder runtime.Decoder, reader client.Reader) (extensionswebhook.Validator, error) {
+	return newShootValidator(decoder, reader)
+}
+
+// ValidateShoot validates the provider configuration of the extension from the
+// given [core.Shoot] in the same way as the validating webhook. If the given
+// [client.Reader] is nil, the referenced secrets are not read, and only the
+// references to the resources of the shoot are validated.
+func ValidateShoot(ctx context.Context, decoder runtime.Decoder, reader client.Reader, shoot *core.Shoot) error {
+	validator := &shootValidator{
+		decoder:       decoder,
+		reader:        reader,
+		extensionType: actuator.ExtensionType,
+	}
+
+	if decoder == nil {
+		return fmt.Errorf("invalid decoder specified for shoot validator %s", validator.extensionType)
+	}
+
+	act, err := newRenderActuator(decoder)
+	if err != nil {
+		return err
+	}
+	validator.actuator = act
+
+	return validator.validateExtension(ctx, shoot, nil)
 }
 
 // Validate implements the [extensionswebhook.Validator] interface.
@@ -84,7 +139,7 @@ func (v *shootValidator) Validate(ctx context.Context, newObj, oldObj client.Obj
 		return nil
 	}
 
-	return v.validateExtension(newShoot, oldShoot)
+	return v.validateExtension(ctx, newShoot, oldShoot)
 }
 
 // getExtension returns the [core.Extension] by extracting it from the given
@@ -106,8 +161,10 @@ func (v *shootValidator) getExtension(obj *core.Shoot) (core.Extension, error) {
 }
 
 // validateExtension validates the extension configuration from the given
-// [core.Shoot] specs.
-func (v *shootValidator) validateExtension(newObj *core.Shoot, _ *core.Shoot) error {
+// [core.Shoot] specs. The referenced secrets are only read from the garden
+// cluster on create, or when the extension or the resources of the shoot have
+// been changed.
+func (v *shootValidator) validateExtension(ctx context.Context, newObj *core.Shoot, oldObj *core.Shoot) error {
 	ext, err := v.getExtension(newObj)
 	if err != nil {
 		return IgnoreExtensionNotFound(err)
@@ -131,16 +188,132 @@ func (v *shootValidator) validateExtension(newObj *core.Shoot, _ *core.Shoot) er
 		return fmt.Errorf("invalid extension configuration for %s: %w", v.extensionType, err)
 	}
 
-	// TODO: additional validation checks, referenced secrets, etc.
+	reader := v.reader
+	if !v.referencesChanged(ext, newObj, oldObj) {
+		reader = nil
+	}
+
+	if err := v.validateReferencedResources(ctx, reader, newObj, cfg); err != nil {
+		return fmt.Errorf("invalid extension configuration for %s: %w", v.extensionType, err)
+	}
+
+	if err := v.validateComponents(newObj, cfg); err != nil {
+		return fmt.Errorf("invalid extension configuration for %s: %w", v.extensionType, err)
+	}
 
 	return nil
 }
 
+// validateComponents renders the objects of the extension for the given
+// [core.Shoot], so that the settings of all components of the collector
+// configuration are validated in the same way as during reconciliation.
+func (v *shootValidator) validateComponents(shoot *core.Shoot, cfg config.CollectorConfig) error {
+	out := &gardencorev1beta1.Shoot{}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(shoot, out, nil); err != nil {
+		return fmt.Errorf("failed to convert shoot: %w", err)
+	}
+
+	projectName := strings.TrimPrefix(out.Namespace, "garden-")
+	namespace := gardenerutils.ComputeTechnicalID(projectName, out)
+	_, err := v.actuator.Render(namespace, cfg, &extensionscontroller.Cluster{Shoot: out})
+
+	return err
+}
+
+// referencesChanged returns true, if the referenced secrets of the given
+// extension need to be checked, i.e. if the shoot is created, or if the
+// extension or the resources of the shoot differ from the old [core.Shoot].
+func (v *shootValidator) referencesChanged(ext core.Extension, newObj, oldObj *core.Shoot) bool {
+	if oldObj == nil {
+		return true
+	}
+
+	oldExt, err := v.getExtension(oldObj)
+	if err != nil {
+		return true
+	}
+
+	return !apiequality.Semantic.DeepEqual(ext, oldExt) ||
+		!apiequality.Semantic.DeepEqual(newObj.Spec.Resources, oldObj.Spec.Resources)
+}
+
+// validateReferencedResources validates that the resources referenced by the
+// extension configuration are specified in the resources of the given
+// [core.Shoot], reference secrets and that the secrets contain the referenced
+// data keys. The secrets are read with the given [client.Reader], and are not
+// checked if it is nil.
+func (v *shootValidator) validateReferencedResources(ctx context.Context, reader client.Reader, shoot *core.Shoot, cfg config.CollectorConfig) error {
+	allErrs := make(field.ErrorList, 0)
+	secrets := make(map[string]*corev1.Secret)
+
+	for _, r := range validation.ReferencedResources(cfg) {
+		namePath := r.Path.Child("resourceRef", "name")
+		dataKeyPath := r.Path.Child("resourceRef", "dataKey")
+
+		idx := slices.IndexFunc(shoot.Spec.Resources, func(res core.NamedResourceReference) bool {
+			return res.Name == r.Ref.ResourceRef.Name
+		})
+		if idx == -1 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(namePath, r.Ref.ResourceRef.Name, "resource is not specified in .spec.resources of the shoot"),
+			)
+
+			continue
+		}
+
+		resourceRef := shoot.Spec.Resources[idx].ResourceRef
+		if resourceRef.APIVersion != corev1.SchemeGroupVersion.String() || resourceRef.Kind != "Secret" {
+			allErrs = append(
+				allErrs,
+				field.Invalid(namePath, r.Ref.ResourceRef.Name, "resource is not a v1/Secret"),
+			)
+
+			continue
+		}
+
+		// Secrets cannot be checked without a reader
+		if reader == nil {
+			continue
+		}
+
+		secret, ok := secrets[resourceRef.Name]
+		if !ok {
+			secret = &corev1.Secret{}
+			if err := reader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: resourceRef.Name}, secret); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return fmt.Errorf("failed to get referenced secret %s: %w", resourceRef.Name, err)
+				}
+				secret = nil
+			}
+			secrets[resourceRef.Name] = secret
+		}
+
+		if secret == nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(namePath, r.Ref.ResourceRef.Name, fmt.Sprintf("secret %s does not exist", resourceRef.Name)),
+			)
+
+			continue
+		}
+
+		if _, ok := secret.Data[r.Ref.ResourceRef.DataKey]; !ok {
+			allErrs = append(
+				allErrs,
+				field.Invalid(dataKeyPath, r.Ref.ResourceRef.DataKey, fmt.Sprintf("key does not exist in secret %s", resourceRef.Name)),
+			)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
 // NewShootValidatorWebhook returns a new validating [extensionswebhook.Webhook]
 // for [core.Shoot] objects.
 func NewShootValidatorWebhook(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	decoder := serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder()
-	validator, err := newShootValidator(decoder)
+	validator, err := newShootValidator(decoder, mgr.GetAPIReader())
 	if err != nil {
 		return nil, err
 	}
@@ -13,11 +13,14 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
 	"github.com/gardener/gardener-extension-otelcol/pkg/admission/validator"
@@ -32,6 +35,7 @@ var _ = Describe("Shoot Validator", Ordered, func() {
 		providerConfigData []byte
 		decoder            = serializer.NewCodecFactory(scheme.Scheme, serializer.EnableStrict).UniversalDecoder()
 		shootValidator     extensionswebhook.Validator
+		reader             client.Reader
 		shoot              *core.Shoot
 		projectNamespace   = &corev1.Namespace{
 			ObjectMeta: metav1.ObjectMeta{
@@ -64,7 +68,16 @@ var _ = Describe("Shoot Validator", Ordered, func() {
 
 	BeforeEach(func() {
 		var err error
-		shootValidator, err = validator.NewShootValidator(decoder)
+		reader = fake.NewClientBuilder().
+			WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-otelcol-token",
+					Namespace: projectNamespace.Name,
+				},
+				Data: map[string][]byte{"token": []byte("my-token")},
+			}).
+			Build()
+		shootValidator, err = validator.NewShootValidator(decoder, reader)
 		Expect(err).NotTo(HaveOccurred())
 		shoot = &core.Shoot{
 			ObjectMeta: metav1.ObjectMeta{
@@ -101,10 +114,15 @@ var _ = Describe("Shoot Validator", Ordered, func() {
 	})
 
 	It("should fail to create shoot validator with invalid decoder", func() {
-		_, err := validator.NewShootValidator(nil)
+		_, err := validator.NewShootValidator(nil, reader)
 		Expect(err).To(MatchError(ContainSubstring("invalid decoder specified")))
 	})
 
+	It("should fail to create shoot validator with invalid reader", func() {
+		_, err := validator.NewShootValidator(decoder, nil)
+		Expect(err).To(MatchError(ContainSubstring("invalid reader specified")))
+	})
+
 	It("should successfully validate when extension is not defined or enabled", func() {
 		Expect(shootValidator.Validate(ctx, shoot, nil)).NotTo(HaveOccurred())
 	})
@@ -135,4 +153,145 @@ var _ = Describe("Shoot Validator", Ordered, func() {
 		err = shootValidator.Validate(ctx, shoot, nil)
 		Expect(err).To(MatchError(ContainSubstring("no exporter enabled")))
 	})
+
+	It("should fail to validate when the settings of a component are invalid", func() {
+		data, err := json.Marshal(config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					DebugExporter: config.DebugExporterConfig{
+						Enabled:   new(true),
+						Verbosity: "loud",
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		shoot.Spec.Extensions = []core.Extension{
+			{
+				Type:           actuator.ExtensionType,
+				ProviderConfig: &runtime.RawExtension{Raw: data},
+			},
+		}
+
+		Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("invalid settings of exporters debug")))
+		Expect(validator.ValidateShoot(ctx, decoder, nil, shoot)).To(MatchError(ContainSubstring("invalid settings of exporters debug")))
+	})
+
+	Context("referenced resources", func() {
+		var tokenRef config.ResourceReference
+
+		withProviderConfig := func() {
+			data, err := json.Marshal(config.CollectorConfig{
+				Spec: config.CollectorConfigSpec{
+					Exporters: config.CollectorExportersConfig{
+						OTLPHTTPExporter: config.OTLPHTTPExporterConfig{
+							Enabled:  new(true),
+							Endpoint: "https://otlp.example.org:4318",
+							Encoding: config.MessageEncodingProto,
+							Token:    &tokenRef,
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			shoot.Spec.Extensions = []core.Extension{
+				{
+					Type:           actuator.ExtensionType,
+					ProviderConfig: &runtime.RawExtension{Raw: data},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			tokenRef = config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otelcol-token", DataKey: "token"},
+			}
+			shoot.Spec.Resources = []core.NamedResourceReference{
+				{
+					Name: "otelcol-token",
+					ResourceRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-otelcol-token",
+					},
+				},
+			}
+		})
+
+		It("should succeed when the referenced secret contains the data key", func() {
+			withProviderConfig()
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+		})
+
+		It("should fail when the resource is not specified in the shoot", func() {
+			tokenRef.ResourceRef.Name = "otelcol-tokn"
+			withProviderConfig()
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(And(
+				ContainSubstring("spec.exporters.otlp_http.token.resourceRef.name"),
+				ContainSubstring("resource is not specified in .spec.resources of the shoot"),
+			)))
+		})
+
+		It("should fail when the resource is not a secret", func() {
+			shoot.Spec.Resources[0].ResourceRef.Kind = "ConfigMap"
+			withProviderConfig()
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("resource is not a v1/Secret")))
+		})
+
+		It("should fail when the secret does not exist", func() {
+			shoot.Spec.Resources[0].ResourceRef.Name = "missing"
+			withProviderConfig()
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("secret missing does not exist")))
+		})
+
+		It("should fail when the secret does not contain the data key", func() {
+			tokenRef.ResourceRef.DataKey = "bearer"
+			withProviderConfig()
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(And(
+				ContainSubstring("spec.exporters.otlp_http.token.resourceRef.dataKey"),
+				ContainSubstring("key does not exist in secret my-otelcol-token"),
+			)))
+		})
+
+		It("should only read the secrets on update, when the extension or the resources have changed", func() {
+			shoot.Spec.Resources[0].ResourceRef.Name = "missing"
+			withProviderConfig()
+			oldShoot := shoot.DeepCopy()
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+
+			shoot.Spec.Resources[0].ResourceRef.Kind = "ConfigMap"
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(MatchError(ContainSubstring("resource is not a v1/Secret")))
+
+			shoot.Spec.Resources[0].ResourceRef.Kind = "Secret"
+			shoot.Spec.Resources = append(shoot.Spec.Resources, core.NamedResourceReference{
+				Name: "other",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Name:       "other",
+				},
+			})
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(MatchError(ContainSubstring("secret missing does not exist")))
+
+			shoot.Spec.Resources = oldShoot.Spec.Resources
+			tokenRef.ResourceRef.DataKey = "bearer"
+			withProviderConfig()
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(MatchError(ContainSubstring("secret missing does not exist")))
+		})
+
+		It("should only validate the references to the shoot resources without a reader", func() {
+			shoot.Spec.Resources[0].ResourceRef.Name = "missing"
+			withProviderConfig()
+			Expect(validator.ValidateShoot(ctx, decoder, nil, shoot)).To(Succeed())
+
+			shoot.Spec.Resources[0].ResourceRef.Kind = "ConfigMap"
+			Expect(validator.ValidateShoot(ctx, decoder, nil, shoot)).To(MatchError(ContainSubstring("resource is not a v1/Secret")))
+		})
+
+		It("should read the referenced secrets with a reader", func() {
+			shoot.Spec.Resources[0].ResourceRef.Name = "missing"
+			withProviderConfig()
+			Expect(validator.ValidateShoot(ctx, decoder, reader, shoot)).To(MatchError(ContainSubstring("secret missing does not exist")))
+		})
+	})
 })
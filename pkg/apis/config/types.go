@@ -5,8 +5,11 @@
 package config
 
 import (
+	"slices"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -113,6 +116,142 @@ type RetryOnFailureConfig struct {
 	Multiplier float64
 }
 
+// AllocationStrategy specifies how the Target Allocator distributes the
+// scrape targets among the collector replicas.
+//
+// See the link below for more details.
+//
+// https://github.com/open-telemetry/opentelemetry-operator/tree/main/cmd/otel-allocator#allocation-strategies
+type AllocationStrategy string
+
+const (
+	// AllocationStrategyConsistentHashing specifies that the scrape targets
+	// are distributed by consistent hashing, which keeps most of the targets
+	// on the same collector, when the number of collectors changes.
+	AllocationStrategyConsistentHashing AllocationStrategy = "consistent-hashing"
+	// AllocationStrategyLeastWeighted specifies that each scrape target is
+	// assigned to the collector with the least number of targets.
+	AllocationStrategyLeastWeighted AllocationStrategy = "least-weighted"
+)
+
+// RelabelAction specifies the action of a [RelabelConfig].
+//
+// See the link below for more details.
+//
+// https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+type RelabelAction string
+
+const (
+	// RelabelActionReplace specifies that the target label is set to the
+	// replacement, if the regex matches the concatenated source labels.
+	RelabelActionReplace RelabelAction = "replace"
+	// RelabelActionKeep specifies that the series, for which the regex does
+	// not match the concatenated source labels, are dropped.
+	RelabelActionKeep RelabelAction = "keep"
+	// RelabelActionDrop specifies that the series, for which the regex
+	// matches the concatenated source labels, are dropped.
+	RelabelActionDrop RelabelAction = "drop"
+	// RelabelActionKeepEqual specifies that the series, for which the
+	// concatenated source labels do not match the target label, are
+	// dropped.
+	RelabelActionKeepEqual RelabelAction = "keepequal"
+	// RelabelActionDropEqual specifies that the series, for which the
+	// concatenated source labels match the target label, are dropped.
+	RelabelActionDropEqual RelabelAction = "dropequal"
+	// RelabelActionHashMod specifies that the target label is set to the
+	// modulus of a hash of the concatenated source labels.
+	RelabelActionHashMod RelabelAction = "hashmod"
+	// RelabelActionLabelMap specifies that the values of all labels, whose
+	// names match the regex, are copied to the labels named by the
+	// replacement.
+	RelabelActionLabelMap RelabelAction = "labelmap"
+	// RelabelActionLabelDrop specifies that all labels, whose names match
+	// the regex, are removed.
+	RelabelActionLabelDrop RelabelAction = "labeldrop"
+	// RelabelActionLabelKeep specifies that all labels, whose names do not
+	// match the regex, are removed.
+	RelabelActionLabelKeep RelabelAction = "labelkeep"
+	// RelabelActionLowercase specifies that the target label is set to the
+	// lowercased concatenated source labels.
+	RelabelActionLowercase RelabelAction = "lowercase"
+	// RelabelActionUppercase specifies that the target label is set to the
+	// uppercased concatenated source labels.
+	RelabelActionUppercase RelabelAction = "uppercase"
+)
+
+// KubernetesObjectMode specifies how the Kubernetes objects of the shoot
+// cluster are collected.
+type KubernetesObjectMode string
+
+const (
+	// KubernetesObjectModePull specifies that the objects are listed
+	// periodically.
+	KubernetesObjectModePull KubernetesObjectMode = "pull"
+	// KubernetesObjectModeWatch specifies that the changes of the objects are
+	// watched.
+	KubernetesObjectModeWatch KubernetesObjectMode = "watch"
+)
+
+// EventType specifies the type of a Kubernetes event.
+type EventType string
+
+const (
+	// EventTypeNormal specifies events, which report a normal operation.
+	EventTypeNormal EventType = "Normal"
+	// EventTypeWarning specifies events, which report a potential problem.
+	EventTypeWarning EventType = "Warning"
+)
+
+// SendingQueueStorage specifies where the sending queue of an exporter is
+// stored.
+type SendingQueueStorage string
+
+const (
+	// SendingQueueStorageMemory specifies that the sending queue is kept in
+	// memory and is lost when the collector restarts.
+	SendingQueueStorageMemory SendingQueueStorage = "memory"
+	// SendingQueueStorageFile specifies that the sending queue is persisted
+	// in the file storage of the collector, which is backed by a persistent
+	// volume.
+	SendingQueueStorageFile SendingQueueStorage = "file"
+)
+
+// SendingQueueConfig provides the sending queue settings of an exporter.
+type SendingQueueConfig struct {
+	// Enabled specifies whether the sending queue is enabled or not.
+	Enabled *bool
+
+	// NumConsumers specifies the number of consumers, which dequeue
+	// batches from the queue. The default value is
+	// [DefaultSendingQueueNumConsumers].
+	NumConsumers int
+
+	// QueueSize specifies the max number of batches in the queue. The
+	// default value is [DefaultSendingQueueSize].
+	QueueSize int
+
+	// Storage specifies where the queue is stored. A queue stored in the
+	// file storage survives restarts of the collector. The default value
+	// is [SendingQueueStorageMemory].
+	Storage SendingQueueStorage
+}
+
+// IsEnabled is a predicate which returns whether the sending queue is enabled
+// or not.
+func (cfg SendingQueueConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return true
+}
+
+// IsPersistent is a predicate which returns whether the sending queue is
+// enabled and persisted in the file storage.
+func (cfg SendingQueueConfig) IsPersistent() bool {
+	return cfg.IsEnabled() && cfg.Storage == SendingQueueStorageFile
+}
+
 // OTLPHTTPExporterConfig provides the OTLP HTTP Exporter configuration settings.
 //
 // See [OTLP HTTP Exporter] for more details.
@@ -177,6 +316,9 @@ type OTLPHTTPExporterConfig struct {
 	// RetryOnFailure specifies the retry policy of the exporter.
 	RetryOnFailure RetryOnFailureConfig
 
+	// SendingQueue specifies the sending queue settings of the exporter.
+	SendingQueue SendingQueueConfig
+
 	// Compression specifies the compression to use.
 	//
 	// Possible options are gzip, zstd, snappy and none.
@@ -261,6 +403,9 @@ type OTLPGRPCExporterConfig struct {
 	// RetryOnFailure specifies the retry policy of the exporter.
 	RetryOnFailure RetryOnFailureConfig
 
+	// SendingQueue specifies the sending queue settings of the exporter.
+	SendingQueue SendingQueueConfig
+
 	// Compression specifies the compression to use. The default value is
 	// [CompressionGzip].
 	Compression Compression
@@ -276,6 +421,203 @@ func (cfg OTLPGRPCExporterConfig) IsEnabled() bool {
 	return false
 }
 
+// PrometheusRemoteWriteWALConfig provides the Write-Ahead Log settings of the
+// Prometheus Remote Write exporter.
+type PrometheusRemoteWriteWALConfig struct {
+	// Enabled specifies whether the Write-Ahead Log is enabled or not.
+	Enabled *bool
+
+	// BufferSize specifies the number of objects to retrieve from the WAL
+	// at once. The default value is
+	// [DefaultPrometheusRemoteWriteWALBufferSize].
+	BufferSize int
+
+	// TruncateFrequency specifies how often the WAL is truncated. The
+	// default value is [DefaultPrometheusRemoteWriteWALTruncateFrequency].
+	TruncateFrequency time.Duration
+}
+
+// IsEnabled is a predicate which returns whether the WAL is enabled or not.
+func (cfg PrometheusRemoteWriteWALConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// PrometheusRemoteWriteExporterConfig provides the Prometheus Remote Write
+// Exporter config settings. The exporter supports the metrics signal only.
+//
+// See [Prometheus Remote Write Exporter] for more details.
+//
+// [Prometheus Remote Write Exporter]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/prometheusremotewriteexporter
+type PrometheusRemoteWriteExporterConfig struct {
+	// Enabled specifies whether the Prometheus Remote Write exporter is
+	// enabled or not.
+	Enabled *bool
+
+	// Endpoint specifies the remote write URL to send the metrics to, e.g.
+	// https://example.com/api/v1/receive
+	Endpoint string
+
+	// TLS specifies the TLS configuration settings for the exporter.
+	TLS *TLSConfig
+
+	// Token references a bearer token for authentication.
+	Token *ResourceReference
+
+	// Timeout specifies the HTTP request time limit. The default value is
+	// [DefaultPrometheusRemoteWriteExporterTimeout].
+	Timeout time.Duration
+
+	// ExternalLabels specifies the labels, which are added to each exported
+	// time series.
+	ExternalLabels map[string]string
+
+	// WAL specifies the Write-Ahead Log settings of the exporter.
+	WAL PrometheusRemoteWriteWALConfig
+
+	// RetryOnFailure specifies the retry policy of the exporter.
+	RetryOnFailure RetryOnFailureConfig
+}
+
+// IsEnabled is a predicate which returns whether the exporter is enabled or
+// not.
+func (cfg PrometheusRemoteWriteExporterConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// KafkaEncoding specifies the encoding of the messages sent to Kafka.
+type KafkaEncoding string
+
+const (
+	// KafkaEncodingOTLPProto specifies that the messages are encoded as OTLP
+	// Protobuf.
+	KafkaEncodingOTLPProto KafkaEncoding = "otlp_proto"
+	// KafkaEncodingOTLPJSON specifies that the messages are encoded as OTLP
+	// JSON.
+	KafkaEncodingOTLPJSON KafkaEncoding = "otlp_json"
+)
+
+// KafkaSASLMechanism specifies the SASL mechanism used to authenticate with
+// the Kafka brokers.
+type KafkaSASLMechanism string
+
+const (
+	// KafkaSASLMechanismPlain specifies the PLAIN SASL mechanism.
+	KafkaSASLMechanismPlain KafkaSASLMechanism = "PLAIN"
+	// KafkaSASLMechanismSCRAMSHA256 specifies the SCRAM-SHA-256 SASL
+	// mechanism.
+	KafkaSASLMechanismSCRAMSHA256 KafkaSASLMechanism = "SCRAM-SHA-256"
+	// KafkaSASLMechanismSCRAMSHA512 specifies the SCRAM-SHA-512 SASL
+	// mechanism.
+	KafkaSASLMechanismSCRAMSHA512 KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+// KafkaTopicsConfig provides the Kafka topics, to which the signals are
+// exported.
+type KafkaTopicsConfig struct {
+	// Logs specifies the topic for logs. The default value is
+	// [DefaultKafkaLogsTopic].
+	Logs string
+
+	// Metrics specifies the topic for metrics. The default value is
+	// [DefaultKafkaMetricsTopic].
+	Metrics string
+
+	// Traces specifies the topic for traces. The default value is
+	// [DefaultKafkaTracesTopic].
+	Traces string
+}
+
+// KafkaSASLConfig provides the SASL authentication settings of the Kafka
+// exporter.
+type KafkaSASLConfig struct {
+	// Mechanism specifies the SASL mechanism. The default value is
+	// [KafkaSASLMechanismSCRAMSHA512].
+	Mechanism KafkaSASLMechanism
+
+	// Username references the username used for authentication.
+	Username *ResourceReference
+
+	// Password references the password used for authentication.
+	Password *ResourceReference
+}
+
+// KafkaExporterConfig provides the Kafka Exporter config settings.
+//
+// See [Kafka Exporter] for more details.
+//
+// [Kafka Exporter]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/kafkaexporter
+type KafkaExporterConfig struct {
+	// Enabled specifies whether the Kafka exporter is enabled or not.
+	Enabled *bool
+
+	// Brokers specifies the addresses of the Kafka brokers in the
+	// host:port format.
+	Brokers []string
+
+	// Topics specifies the topics, to which the signals are exported.
+	Topics KafkaTopicsConfig
+
+	// Encoding specifies the encoding of the messages. The default value is
+	// [KafkaEncodingOTLPProto].
+	Encoding KafkaEncoding
+
+	// SASL specifies the SASL authentication settings of the exporter.
+	SASL *KafkaSASLConfig
+
+	// TLS specifies the TLS configuration settings for the exporter.
+	TLS *TLSConfig
+
+	// Timeout specifies the time to wait per individual attempt to send
+	// data to the brokers. The default value is
+	// [DefaultKafkaExporterTimeout].
+	Timeout time.Duration
+
+	// RetryOnFailure specifies the retry policy of the exporter.
+	RetryOnFailure RetryOnFailureConfig
+}
+
+// IsEnabled is a predicate which returns whether the exporter is enabled or
+// not.
+func (cfg KafkaExporterConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// NamedOTLPHTTPExporterConfig provides the settings for a named OTLP HTTP
+// exporter.
+type NamedOTLPHTTPExporterConfig struct {
+	// Name specifies the name of the exporter, which must be a valid DNS
+	// label. The exporter can be referenced by the pipelines as
+	// otlp_http/<name>.
+	Name string
+
+	// OTLPHTTPExporterConfig provides the OTLP HTTP Exporter settings.
+	OTLPHTTPExporterConfig
+}
+
+// NamedOTLPGRPCExporterConfig provides the settings for a named OTLP gRPC
+// exporter.
+type NamedOTLPGRPCExporterConfig struct {
+	// Name specifies the name of the exporter, which must be a valid DNS
+	// label. The exporter can be referenced by the pipelines as
+	// otlp_grpc/<name>.
+	Name string
+
+	// OTLPGRPCExporterConfig provides the OTLP gRPC Exporter settings.
+	OTLPGRPCExporterConfig
+}
+
 // CollectorExportersConfig provides the OTLP exporter settings.
 type CollectorExportersConfig struct {
 	// OTLPGRPCExporter provides the OTLP gRPC Exporter settings.
@@ -286,6 +628,141 @@ type CollectorExportersConfig struct {
 
 	// DebugExporter provides the settings for the debug exporter.
 	DebugExporter DebugExporterConfig
+
+	// PrometheusRemoteWriteExporter provides the settings for the
+	// Prometheus Remote Write exporter.
+	PrometheusRemoteWriteExporter PrometheusRemoteWriteExporterConfig
+
+	// KafkaExporter provides the settings for the Kafka exporter.
+	KafkaExporter KafkaExporterConfig
+
+	// NamedOTLPGRPCExporters provides the settings for additional OTLP
+	// gRPC exporters, each of which is identified by its name.
+	NamedOTLPGRPCExporters []NamedOTLPGRPCExporterConfig
+
+	// NamedOTLPHTTPExporters provides the settings for additional OTLP
+	// HTTP exporters, each of which is identified by its name.
+	NamedOTLPHTTPExporters []NamedOTLPHTTPExporterConfig
+}
+
+const (
+	// ExporterNameDebug is the name of the debug exporter, which may be
+	// referenced by the pipelines.
+	ExporterNameDebug = "debug"
+	// ExporterNameOTLPHTTP is the name of the OTLP HTTP exporter, which may
+	// be referenced by the pipelines.
+	ExporterNameOTLPHTTP = "otlp_http"
+	// ExporterNameOTLPGRPC is the name of the OTLP gRPC exporter, which may
+	// be referenced by the pipelines.
+	ExporterNameOTLPGRPC = "otlp_grpc"
+	// ExporterNamePrometheusRemoteWrite is the name of the Prometheus Remote
+	// Write exporter, which may be referenced by the metrics pipeline.
+	ExporterNamePrometheusRemoteWrite = "prometheusremotewrite"
+	// ExporterNameKafka is the name of the Kafka exporter, which may be
+	// referenced by the pipelines.
+	ExporterNameKafka = "kafka"
+)
+
+// Signal specifies a type of telemetry data processed by the pipelines.
+type Signal string
+
+const (
+	// SignalLogs specifies the logs signal, which is processed by the logs
+	// and events pipelines.
+	SignalLogs Signal = "logs"
+	// SignalMetrics specifies the metrics signal.
+	SignalMetrics Signal = "metrics"
+	// SignalTraces specifies the traces signal.
+	SignalTraces Signal = "traces"
+)
+
+// ExporterSupportsSignal is a predicate which returns whether the exporter
+// with the given name supports the given signal.
+func ExporterSupportsSignal(name string, signal Signal) bool {
+	if name == ExporterNamePrometheusRemoteWrite {
+		return signal == SignalMetrics
+	}
+
+	return true
+}
+
+// ExporterID returns the component ID of the named exporter of the given
+// type, e.g. otlp_http/<name>.
+func ExporterID(exporterType, name string) string {
+	return exporterType + "/" + name
+}
+
+// ExporterNames returns the sorted names of all exporters, which can be
+// referenced by the pipelines.
+func (cfg CollectorExportersConfig) ExporterNames() []string {
+	names := []string{
+		ExporterNameDebug,
+		ExporterNameKafka,
+		ExporterNameOTLPGRPC,
+		ExporterNameOTLPHTTP,
+		ExporterNamePrometheusRemoteWrite,
+	}
+
+	for _, exporter := range cfg.NamedOTLPGRPCExporters {
+		names = append(names, ExporterID(ExporterNameOTLPGRPC, exporter.Name))
+	}
+
+	for _, exporter := range cfg.NamedOTLPHTTPExporters {
+		names = append(names, ExporterID(ExporterNameOTLPHTTP, exporter.Name))
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// EnabledExporterNames returns the sorted names of the enabled exporters.
+func (cfg CollectorExportersConfig) EnabledExporterNames() []string {
+	names := make([]string, 0)
+
+	if cfg.DebugExporter.IsEnabled() {
+		names = append(names, ExporterNameDebug)
+	}
+
+	if cfg.OTLPGRPCExporter.IsEnabled() {
+		names = append(names, ExporterNameOTLPGRPC)
+	}
+
+	if cfg.OTLPHTTPExporter.IsEnabled() {
+		names = append(names, ExporterNameOTLPHTTP)
+	}
+
+	if cfg.PrometheusRemoteWriteExporter.IsEnabled() {
+		names = append(names, ExporterNamePrometheusRemoteWrite)
+	}
+
+	if cfg.KafkaExporter.IsEnabled() {
+		names = append(names, ExporterNameKafka)
+	}
+
+	for _, exporter := range cfg.NamedOTLPGRPCExporters {
+		if exporter.IsEnabled() {
+			names = append(names, ExporterID(ExporterNameOTLPGRPC, exporter.Name))
+		}
+	}
+
+	for _, exporter := range cfg.NamedOTLPHTTPExporters {
+		if exporter.IsEnabled() {
+			names = append(names, ExporterID(ExporterNameOTLPHTTP, exporter.Name))
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// EnabledExporterNamesForSignal returns the sorted names of the enabled
+// exporters, which support the given signal.
+func (cfg CollectorExportersConfig) EnabledExporterNamesForSignal(signal Signal) []string {
+	return slices.DeleteFunc(cfg.EnabledExporterNames(), func(name string) bool {
+		return !ExporterSupportsSignal(name, signal)
+	})
 }
 
 // CollectorLogsConfig provides the settings for the collector internal logs.
@@ -301,8 +778,80 @@ type CollectorLogsConfig struct {
 	Encoding LogEncoding
 }
 
+// MetricsScrapeConfig provides the settings for selecting and scraping the
+// metrics endpoints of the control-plane components via the Target Allocator.
+type MetricsScrapeConfig struct {
+	// Interval specifies the interval, in which the targets of monitors
+	// without an explicit interval are scraped. The default value is
+	// [DefaultScrapeInterval].
+	Interval time.Duration
+
+	// AllocationStrategy specifies how the Target Allocator distributes the
+	// scrape targets among the collector replicas.
+	AllocationStrategy AllocationStrategy
+
+	// ServiceMonitorSelector specifies the label selector for the
+	// ServiceMonitors in the shoot control-plane namespace, which are
+	// picked up. When not specified, the ServiceMonitors with the
+	// `prometheus: shoot` label are picked up.
+	ServiceMonitorSelector *metav1.LabelSelector
+
+	// PodMonitorSelector specifies the label selector for the PodMonitors in
+	// the shoot control-plane namespace, which are picked up. When not
+	// specified, no PodMonitors are picked up.
+	PodMonitorSelector *metav1.LabelSelector
+
+	// ScrapeConfigSelector specifies the label selector for the
+	// ScrapeConfigs in the shoot control-plane namespace, which are picked
+	// up. When not specified, no ScrapeConfigs are picked up.
+	ScrapeConfigSelector *metav1.LabelSelector
+
+	// MetricRelabelConfigs specifies the metric relabeling rules, which are
+	// applied to the samples of all targets handed out by the Target
+	// Allocator, e.g. in order to drop series or to rename labels, before
+	// they are sent to the backends.
+	MetricRelabelConfigs []RelabelConfig
+}
+
+// RelabelConfig provides the settings for a Prometheus relabeling rule.
+//
+// See the link below for more details.
+//
+// https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+type RelabelConfig struct {
+	// SourceLabels specifies the labels, whose values are concatenated and
+	// matched against the regex.
+	SourceLabels []string
+
+	// Separator specifies the separator placed between the concatenated
+	// source label values. When not specified, the Prometheus default `;`
+	// is used.
+	Separator *string
+
+	// TargetLabel specifies the label, to which the resulting value is
+	// written.
+	TargetLabel string
+
+	// Regex specifies the RE2 regular expression, against which the
+	// concatenated source label values are matched. When not specified, the
+	// Prometheus default `(.*)` is used.
+	Regex string
+
+	// Modulus specifies the modulus to take of the hash of the concatenated
+	// source label values. It is required for the `hashmod` action.
+	Modulus uint64
+
+	// Replacement specifies the replacement value, against which a regex
+	// replace is performed, if the regex matches. When not specified, the
+	// Prometheus default `$1` is used.
+	Replacement *string
+
+	// Action specifies the action to perform based on the regex matching.
+	Action RelabelAction
+}
+
 // CollectorMetricsConfig provides the settings for the collector internal
-// metrics.
+// metrics and for scraping the metrics of the control-plane components.
 //
 // See [Metrics verbosity] for more details.
 //
@@ -310,6 +859,492 @@ type CollectorLogsConfig struct {
 type CollectorMetricsConfig struct {
 	// Level specifies the collector internal metrics verbosity level.
 	Level MetricsVerbosityLevel
+
+	// Scrape specifies the settings for scraping the metrics of the
+	// control-plane components.
+	Scrape MetricsScrapeConfig
+}
+
+// PipelineConfig provides the settings for a collector pipeline, which is
+// enabled by default.
+type PipelineConfig struct {
+	// Enabled specifies whether the pipeline is enabled or not.
+	Enabled *bool
+
+	// Exporters specifies the names of the exporters, which receive the
+	// signals of the pipeline, e.g. otlp_grpc. When empty, the signals are
+	// sent to all enabled exporters.
+	Exporters []string
+}
+
+// IsEnabled is a predicate which returns whether the pipeline is enabled or
+// not.
+func (cfg PipelineConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return true
+}
+
+// TracesPipelineConfig provides the settings for the traces pipeline of the
+// collector, which is disabled by default.
+type TracesPipelineConfig struct {
+	// Enabled specifies whether the traces pipeline is enabled or not.
+	Enabled *bool
+
+	// Exporters specifies the names of the exporters, which receive the
+	// signals of the pipeline, e.g. otlp_grpc. When empty, the signals are
+	// sent to all enabled exporters.
+	Exporters []string
+}
+
+// IsEnabled is a predicate which returns whether the pipeline is enabled or
+// not.
+func (cfg TracesPipelineConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// CollectorPipelinesConfig provides the settings for the signal pipelines of
+// the collector.
+type CollectorPipelinesConfig struct {
+	// Logs provides the settings for the logs pipeline, which receives
+	// logs via OTLP.
+	Logs PipelineConfig
+
+	// Events provides the settings for the events pipeline, which collects
+	// the Kubernetes events from the shoot cluster.
+	Events PipelineConfig
+
+	// Metrics provides the settings for the metrics pipeline, which
+	// scrapes the targets discovered by the Target Allocator.
+	Metrics PipelineConfig
+
+	// Traces provides the settings for the traces pipeline, which receives
+	// traces via OTLP.
+	Traces TracesPipelineConfig
+}
+
+// ResourceAttributesConfig provides the settings for the resource attributes,
+// which the collector adds to the telemetry data.
+//
+// In addition to the attributes configured here, the collector adds
+// attributes derived from the shoot, e.g. the name of the seed, the region,
+// the provider type and the Kubernetes version of the shoot.
+type ResourceAttributesConfig struct {
+	// Static specifies additional attributes with static values. Keys in
+	// the `gardener.` namespace and `k8s.cluster.name` are reserved for the
+	// attributes derived from the shoot.
+	Static map[string]string
+
+	// ShootLabels specifies the keys of the shoot labels, which are added
+	// as `gardener.shoot.label.<key>` attributes, when present.
+	ShootLabels []string
+
+	// ShootAnnotations specifies the keys of the shoot annotations, which
+	// are added as `gardener.shoot.annotation.<key>` attributes, when
+	// present.
+	ShootAnnotations []string
+}
+
+// FilterErrorMode specifies how the filter processors handle errors, which
+// occur while evaluating the conditions.
+type FilterErrorMode string
+
+const (
+	// FilterErrorModeIgnore specifies that errors are logged and the
+	// telemetry data is kept.
+	FilterErrorModeIgnore FilterErrorMode = "ignore"
+	// FilterErrorModeSilent specifies that errors are not logged and the
+	// telemetry data is kept.
+	FilterErrorModeSilent FilterErrorMode = "silent"
+	// FilterErrorModePropagate specifies that errors are returned up the
+	// pipeline, which results in the telemetry data being dropped.
+	FilterErrorModePropagate FilterErrorMode = "propagate"
+)
+
+// LogsFilterConfig provides the OTTL conditions for dropping log records. A
+// log record is dropped when any of the conditions matches.
+type LogsFilterConfig struct {
+	// LogRecord specifies the conditions evaluated against log records,
+	// e.g. `severity_number < SEVERITY_NUMBER_INFO`.
+	LogRecord []string
+}
+
+// IsEnabled is a predicate which returns whether any condition is specified
+// or not.
+func (cfg LogsFilterConfig) IsEnabled() bool {
+	return len(cfg.LogRecord) > 0
+}
+
+// MetricsFilterConfig provides the OTTL conditions for dropping metrics and
+// data points. A metric or data point is dropped when any of the conditions
+// matches.
+type MetricsFilterConfig struct {
+	// Metric specifies the conditions evaluated against metrics, e.g.
+	// `name == "apiserver_request_duration_seconds_bucket"`.
+	Metric []string
+
+	// DataPoint specifies the conditions evaluated against data points,
+	// e.g. `attributes["resource"] == "events"`.
+	DataPoint []string
+}
+
+// IsEnabled is a predicate which returns whether any condition is specified
+// or not.
+func (cfg MetricsFilterConfig) IsEnabled() bool {
+	return len(cfg.Metric) > 0 || len(cfg.DataPoint) > 0
+}
+
+// TracesFilterConfig provides the OTTL conditions for dropping spans and span
+// events. A span or span event is dropped when any of the conditions matches.
+type TracesFilterConfig struct {
+	// Span specifies the conditions evaluated against spans.
+	Span []string
+
+	// SpanEvent specifies the conditions evaluated against span events.
+	SpanEvent []string
+}
+
+// IsEnabled is a predicate which returns whether any condition is specified
+// or not.
+func (cfg TracesFilterConfig) IsEnabled() bool {
+	return len(cfg.Span) > 0 || len(cfg.SpanEvent) > 0
+}
+
+// FiltersConfig provides the settings for dropping telemetry data before it
+// is exported.
+//
+// See [Filter Processor] for more details.
+//
+// [Filter Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/filterprocessor
+type FiltersConfig struct {
+	// ErrorMode specifies how errors, which occur while evaluating the
+	// conditions, are handled. The default value is [FilterErrorModeIgnore].
+	ErrorMode FilterErrorMode
+
+	// Logs specifies the conditions for the logs pipeline.
+	Logs LogsFilterConfig
+
+	// Metrics specifies the conditions for the metrics pipeline.
+	Metrics MetricsFilterConfig
+
+	// Traces specifies the conditions for the traces pipeline.
+	Traces TracesFilterConfig
+}
+
+// ProbabilisticSamplerConfig provides the settings for the probabilistic
+// sampler of the traces pipeline.
+//
+// See [Probabilistic Sampling Processor] for more details.
+//
+// [Probabilistic Sampling Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/probabilisticsamplerprocessor
+type ProbabilisticSamplerConfig struct {
+	// Enabled specifies whether the probabilistic sampler is enabled or not.
+	Enabled *bool
+
+	// SamplingPercentage specifies the percentage of traces, which are
+	// sampled, e.g. 10 for 10% of the traces.
+	SamplingPercentage float64
+}
+
+// IsEnabled is a predicate which returns whether the probabilistic sampler is
+// enabled or not.
+func (cfg ProbabilisticSamplerConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// TailSamplingPolicyType specifies the type of a tail sampling policy.
+type TailSamplingPolicyType string
+
+const (
+	// TailSamplingPolicyTypeLatency specifies a policy, which samples
+	// traces based on their duration.
+	TailSamplingPolicyTypeLatency TailSamplingPolicyType = "latency"
+	// TailSamplingPolicyTypeStatusCode specifies a policy, which samples
+	// traces based on the status code of their spans.
+	TailSamplingPolicyTypeStatusCode TailSamplingPolicyType = "status_code"
+	// TailSamplingPolicyTypeStringAttribute specifies a policy, which
+	// samples traces based on the values of a string attribute.
+	TailSamplingPolicyTypeStringAttribute TailSamplingPolicyType = "string_attribute"
+)
+
+// LatencyPolicyConfig provides the settings for a latency tail sampling
+// policy.
+type LatencyPolicyConfig struct {
+	// ThresholdMs specifies the lower bound of the trace duration in
+	// milliseconds, above which traces are sampled.
+	ThresholdMs int64
+
+	// UpperThresholdMs specifies the upper bound of the trace duration in
+	// milliseconds. When specified, only traces with a duration between
+	// both thresholds are sampled.
+	UpperThresholdMs int64
+}
+
+// StatusCodePolicyConfig provides the settings for a status code tail
+// sampling policy.
+type StatusCodePolicyConfig struct {
+	// StatusCodes specifies the span status codes, i.e. OK, ERROR or UNSET,
+	// for which traces are sampled.
+	StatusCodes []string
+}
+
+// StringAttributePolicyConfig provides the settings for a string attribute
+// tail sampling policy.
+type StringAttributePolicyConfig struct {
+	// Key specifies the key of the attribute.
+	Key string
+
+	// Values specifies the values of the attribute, for which traces are
+	// sampled.
+	Values []string
+
+	// EnabledRegexMatching specifies whether the values are regular
+	// expressions.
+	EnabledRegexMatching bool
+
+	// InvertMatch specifies whether the match is inverted, i.e. traces are
+	// sampled when the attribute does not match the values.
+	InvertMatch bool
+}
+
+// TailSamplingPolicy provides the settings for a tail sampling policy. Only
+// the settings for the type of the policy are taken into account.
+type TailSamplingPolicy struct {
+	// Name specifies the name of the policy.
+	Name string
+
+	// Type specifies the type of the policy.
+	Type TailSamplingPolicyType
+
+	// Latency provides the settings for a latency policy.
+	Latency *LatencyPolicyConfig
+
+	// StatusCode provides the settings for a status code policy.
+	StatusCode *StatusCodePolicyConfig
+
+	// StringAttribute provides the settings for a string attribute policy.
+	StringAttribute *StringAttributePolicyConfig
+}
+
+// TailSamplingConfig provides the settings for the tail sampling of the
+// traces pipeline. A trace is sampled when any of the policies samples it.
+//
+// See [Tail Sampling Processor] for more details.
+//
+// [Tail Sampling Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/tailsamplingprocessor
+type TailSamplingConfig struct {
+	// Enabled specifies whether the tail sampling is enabled or not.
+	Enabled *bool
+
+	// DecisionWait specifies the time to wait since the first span of a
+	// trace, before making a sampling decision. The default value is
+	// [DefaultTailSamplingDecisionWait].
+	DecisionWait time.Duration
+
+	// NumTraces specifies the number of traces kept in memory. The default
+	// value is [DefaultTailSamplingNumTraces].
+	NumTraces int
+
+	// Policies specifies the policies, which decide whether a trace is
+	// sampled.
+	Policies []TailSamplingPolicy
+}
+
+// IsEnabled is a predicate which returns whether the tail sampling is enabled
+// or not.
+func (cfg TailSamplingConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// SamplingConfig provides the sampling settings for the traces pipeline.
+type SamplingConfig struct {
+	// Probabilistic provides the settings for the probabilistic sampler.
+	Probabilistic ProbabilisticSamplerConfig
+
+	// TailSampling provides the settings for the tail sampling.
+	TailSampling TailSamplingConfig
+}
+
+// CollectorStorageConfig provides the settings for the persistent volume of
+// the collector, which backs the file storage of the sending queues.
+type CollectorStorageConfig struct {
+	// Size specifies the size of the persistent volume. The default value
+	// is 1Gi.
+	Size *resource.Quantity
+
+	// StorageClassName specifies the name of the storage class of the
+	// persistent volume. When not specified, the default storage class is
+	// used.
+	StorageClassName *string
+}
+
+// AutoscalingConfig provides the settings of the horizontal pod autoscaler of
+// the collector.
+type AutoscalingConfig struct {
+	// MinReplicas specifies the lower limit for the number of replicas. The
+	// default value is 1.
+	MinReplicas *int32
+
+	// MaxReplicas specifies the upper limit for the number of replicas.
+	MaxReplicas int32
+
+	// TargetCPUUtilization specifies the target average CPU utilization in
+	// percent of the requested CPU. When neither the CPU nor the memory
+	// utilization is specified, the CPU utilization target defaults to 80.
+	TargetCPUUtilization *int32
+
+	// TargetMemoryUtilization specifies the target average memory
+	// utilization in percent of the requested memory.
+	TargetMemoryUtilization *int32
+}
+
+// CollectorScalingConfig provides the scaling settings of the collector.
+type CollectorScalingConfig struct {
+	// Replicas specifies the number of replicas of the collector. The scrape
+	// targets are distributed among the replicas by the Target Allocator.
+	// The setting is ignored, when autoscaling is configured. The default
+	// value is 1.
+	Replicas *int32
+
+	// Resources specifies the compute resources of the collector
+	// container. When not specified, 10m CPU and 50Mi memory are requested.
+	Resources *corev1.ResourceRequirements
+
+	// Autoscaling specifies the settings of the horizontal pod autoscaler of
+	// the collector. When not specified, the collector is not autoscaled.
+	Autoscaling *AutoscalingConfig
+}
+
+// TargetAllocatorScalingConfig provides the scaling settings of the Target
+// Allocator.
+type TargetAllocatorScalingConfig struct {
+	// Replicas specifies the number of replicas of the Target Allocator. The
+	// default value is 1.
+	Replicas *int32
+
+	// Resources specifies the compute resources of the Target Allocator
+	// container. When not specified, 10m CPU and 50Mi memory are requested.
+	Resources *corev1.ResourceRequirements
+}
+
+// ScalingConfig provides the scaling settings of the collector and the Target
+// Allocator. A PodDisruptionBudget is rendered for each of them, when it runs
+// with more than one replica.
+type ScalingConfig struct {
+	// Collector specifies the scaling settings of the collector.
+	Collector CollectorScalingConfig
+
+	// TargetAllocator specifies the scaling settings of the Target
+	// Allocator.
+	TargetAllocator TargetAllocatorScalingConfig
+}
+
+// KubernetesObjectConfig provides the settings for collecting a kind of
+// Kubernetes objects from the shoot cluster.
+//
+// See [Kubernetes Objects Receiver] for more details.
+//
+// [Kubernetes Objects Receiver]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/k8sobjectsreceiver
+type KubernetesObjectConfig struct {
+	// Name specifies the plural name of the resource, e.g. pods.
+	Name string
+
+	// Group specifies the API group of the resource, e.g. events.k8s.io.
+	// When empty, the resource belongs to the core API group.
+	Group string
+
+	// Mode specifies whether the objects are pulled periodically or watched.
+	Mode KubernetesObjectMode
+
+	// Interval specifies the interval, in which the objects are pulled. It
+	// is ignored in watch mode. The default value is
+	// [DefaultKubernetesObjectInterval].
+	Interval time.Duration
+
+	// Namespaces specifies the namespaces, from which the objects are
+	// collected. When empty, the objects are collected from all namespaces.
+	// The namespaces must exist in the shoot cluster, as the collector is
+	// granted access via a Role in each of them. They must not be specified
+	// for cluster-scoped resources.
+	Namespaces []string
+
+	// LabelSelector specifies the label selector for the collected objects,
+	// e.g. `environment=production`.
+	LabelSelector string
+
+	// FieldSelector specifies the field selector for the collected objects,
+	// e.g. `status.phase=Running`.
+	FieldSelector string
+}
+
+// IsEvents is a predicate which returns whether the objects are Kubernetes
+// events of the core or the events.k8s.io API group or not.
+func (cfg KubernetesObjectConfig) IsEvents() bool {
+	return cfg.Name == "events" && (cfg.Group == "" || cfg.Group == "events.k8s.io")
+}
+
+// EventsConfig provides the settings for filtering the Kubernetes events,
+// which are collected from the shoot cluster by the events pipeline.
+type EventsConfig struct {
+	// Namespaces specifies the namespaces, from which the events are
+	// collected. When empty, the events are collected from all namespaces.
+	// The namespaces must exist in the shoot cluster.
+	Namespaces []string
+
+	// ExcludeNamespaces specifies the namespaces, whose events are dropped.
+	ExcludeNamespaces []string
+
+	// Types specifies the types of the events, which are kept. When empty,
+	// the events of all types are kept.
+	Types []EventType
+
+	// Reasons specifies the reasons of the events, which are kept, e.g.
+	// `FailedScheduling`. When empty, the events with any reason are kept.
+	Reasons []string
+
+	// ExcludeReasons specifies the reasons of the events, which are
+	// dropped, e.g. `Pulled`.
+	ExcludeReasons []string
+
+	// OnlyNewEvents specifies whether only the creation of the watched
+	// events is collected, but neither their updates nor their deletion.
+	// Kubernetes aggregates repeated occurrences into the same event by
+	// updating its count, hence the recurrences of an event are dropped as
+	// well, including the ones of Warning events.
+	OnlyNewEvents *bool
+}
+
+// IsFiltered is a predicate which returns whether any of the events are
+// dropped by a filter or not.
+func (cfg EventsConfig) IsFiltered() bool {
+	return len(cfg.ExcludeNamespaces) > 0 ||
+		len(cfg.Types) > 0 ||
+		len(cfg.Reasons) > 0 ||
+		len(cfg.ExcludeReasons) > 0
+}
+
+// IsOnlyNewEvents is a predicate which returns whether only the creation of
+// the watched events is collected or not.
+func (cfg EventsConfig) IsOnlyNewEvents() bool {
+	if cfg.OnlyNewEvents != nil {
+		return *cfg.OnlyNewEvents
+	}
+
+	return false
 }
 
 // CollectorConfigSpec specifies the desired state of [CollectorConfig]
@@ -317,6 +1352,39 @@ type CollectorConfigSpec struct {
 	// Exporters specifies the exporters configuration of the collector.
 	Exporters CollectorExportersConfig
 
+	// Pipelines specifies the settings for the signal pipelines of the
+	// collector.
+	Pipelines CollectorPipelinesConfig
+
+	// KubernetesObjects specifies the Kubernetes objects, which are collected
+	// from the shoot cluster by the events pipeline. When empty, the events
+	// of the events.k8s.io API group are watched in all namespaces.
+	KubernetesObjects []KubernetesObjectConfig
+
+	// Events specifies the settings for filtering the Kubernetes events,
+	// which are collected from the shoot cluster.
+	Events EventsConfig
+
+	// ResourceAttributes specifies the settings for the resource attributes,
+	// which are added to the telemetry data.
+	ResourceAttributes ResourceAttributesConfig
+
+	// Sampling specifies the sampling settings for the traces pipeline.
+	Sampling SamplingConfig
+
+	// Filters specifies the conditions for dropping telemetry data before
+	// it is exported.
+	Filters FiltersConfig
+
+	// Storage specifies the settings for the persistent volume of the
+	// collector, which is used when the sending queue of an exporter is
+	// stored in the file storage.
+	Storage CollectorStorageConfig
+
+	// Scaling specifies the replicas, compute resources and autoscaling
+	// settings of the collector and the Target Allocator.
+	Scaling ScalingConfig
+
 	// Logs specifies the settings for the collector logs.
 	Logs CollectorLogsConfig
 
@@ -334,6 +1402,61 @@ type CollectorConfig struct {
 	Spec CollectorConfigSpec
 }
 
+// ExporterStatus provides the status of an enabled exporter.
+type ExporterStatus struct {
+	// Name specifies the name of the exporter, as referenced in the
+	// collector configuration, e.g. otlp_http or otlp_grpc/<name>.
+	Name string
+
+	// Endpoint specifies the endpoint to which the exporter sends data. For
+	// the Kafka exporter it contains the comma-separated brokers.
+	Endpoint string
+}
+
+// CertificateStatus provides the status of a certificate, which is managed
+// by the extension.
+type CertificateStatus struct {
+	// Name specifies the name of the certificate.
+	Name string
+
+	// NotAfter specifies the time after which the certificate expires.
+	NotAfter metav1.Time
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CollectorStatus provides the status of the OpenTelemetry Collector managed
+// by the extension, which is written to the provider status of the
+// Extension resource.
+type CollectorStatus struct {
+	metav1.TypeMeta
+
+	// Pipelines specifies the names of the enabled pipelines.
+	Pipelines []string
+
+	// Exporters provides the status of the enabled exporters.
+	Exporters []ExporterStatus
+
+	// ConfigHash specifies the SHA-256 hash of the rendered collector
+	// configuration.
+	ConfigHash string
+
+	// CollectorVersion specifies the image version of the OpenTelemetry
+	// Collector.
+	CollectorVersion string
+
+	// TargetAllocatorVersion specifies the image version of the Target
+	// Allocator.
+	TargetAllocatorVersion string
+
+	// Certificates provides the status of the currently active certificates
+	// used for the communication between the collector and the Target
+	// Allocator. Only the certificates generated by the extension are
+	// included, the certificates in the secrets referenced by the TLS
+	// settings of the exporters are not reported.
+	Certificates []CertificateStatus
+}
+
 // TLSConfig provides the TLS settings used by exporters.
 type TLSConfig struct {
 	// InsecureSkipVerify specifies whether to skip verifying the
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// SetDefaults_NamedOTLPHTTPExporterConfig sets the defaults for a named OTLP
+// HTTP exporter. Unlike the singleton exporter, a named exporter is enabled
+// by default, since it is listed explicitly.
+func SetDefaults_NamedOTLPHTTPExporterConfig(obj *NamedOTLPHTTPExporterConfig) {
+	if obj.Enabled == nil {
+		obj.Enabled = new(true)
+	}
+}
+
+// SetDefaults_NamedOTLPGRPCExporterConfig sets the defaults for a named OTLP
+// gRPC exporter. Unlike the singleton exporter, a named exporter is enabled
+// by default, since it is listed explicitly.
+func SetDefaults_NamedOTLPGRPCExporterConfig(obj *NamedOTLPGRPCExporterConfig) {
+	if obj.Enabled == nil {
+		obj.Enabled = new(true)
+	}
+}
@@ -10,6 +10,9 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -21,6 +24,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AutoscalingConfig)(nil), (*config.AutoscalingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AutoscalingConfig_To_config_AutoscalingConfig(a.(*AutoscalingConfig), b.(*config.AutoscalingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AutoscalingConfig)(nil), (*AutoscalingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AutoscalingConfig_To_v1alpha1_AutoscalingConfig(a.(*config.AutoscalingConfig), b.(*AutoscalingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateStatus)(nil), (*config.CertificateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CertificateStatus_To_config_CertificateStatus(a.(*CertificateStatus), b.(*config.CertificateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CertificateStatus)(nil), (*CertificateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CertificateStatus_To_v1alpha1_CertificateStatus(a.(*config.CertificateStatus), b.(*CertificateStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CollectorConfig)(nil), (*config.CollectorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CollectorConfig_To_config_CollectorConfig(a.(*CollectorConfig), b.(*config.CollectorConfig), scope)
 	}); err != nil {
@@ -71,6 +94,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CollectorPipelinesConfig)(nil), (*config.CollectorPipelinesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(a.(*CollectorPipelinesConfig), b.(*config.CollectorPipelinesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CollectorPipelinesConfig)(nil), (*CollectorPipelinesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(a.(*config.CollectorPipelinesConfig), b.(*CollectorPipelinesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CollectorScalingConfig)(nil), (*config.CollectorScalingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CollectorScalingConfig_To_config_CollectorScalingConfig(a.(*CollectorScalingConfig), b.(*config.CollectorScalingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CollectorScalingConfig)(nil), (*CollectorScalingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CollectorScalingConfig_To_v1alpha1_CollectorScalingConfig(a.(*config.CollectorScalingConfig), b.(*CollectorScalingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CollectorStatus)(nil), (*config.CollectorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CollectorStatus_To_config_CollectorStatus(a.(*CollectorStatus), b.(*config.CollectorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CollectorStatus)(nil), (*CollectorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CollectorStatus_To_v1alpha1_CollectorStatus(a.(*config.CollectorStatus), b.(*CollectorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CollectorStorageConfig)(nil), (*config.CollectorStorageConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CollectorStorageConfig_To_config_CollectorStorageConfig(a.(*CollectorStorageConfig), b.(*config.CollectorStorageConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CollectorStorageConfig)(nil), (*CollectorStorageConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CollectorStorageConfig_To_v1alpha1_CollectorStorageConfig(a.(*config.CollectorStorageConfig), b.(*CollectorStorageConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DebugExporterConfig)(nil), (*config.DebugExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(a.(*DebugExporterConfig), b.(*config.DebugExporterConfig), scope)
 	}); err != nil {
@@ -81,6 +144,136 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EventsConfig)(nil), (*config.EventsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EventsConfig_To_config_EventsConfig(a.(*EventsConfig), b.(*config.EventsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EventsConfig)(nil), (*EventsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EventsConfig_To_v1alpha1_EventsConfig(a.(*config.EventsConfig), b.(*EventsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExporterStatus)(nil), (*config.ExporterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExporterStatus_To_config_ExporterStatus(a.(*ExporterStatus), b.(*config.ExporterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ExporterStatus)(nil), (*ExporterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ExporterStatus_To_v1alpha1_ExporterStatus(a.(*config.ExporterStatus), b.(*ExporterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FiltersConfig)(nil), (*config.FiltersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FiltersConfig_To_config_FiltersConfig(a.(*FiltersConfig), b.(*config.FiltersConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FiltersConfig)(nil), (*FiltersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FiltersConfig_To_v1alpha1_FiltersConfig(a.(*config.FiltersConfig), b.(*FiltersConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KafkaExporterConfig)(nil), (*config.KafkaExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KafkaExporterConfig_To_config_KafkaExporterConfig(a.(*KafkaExporterConfig), b.(*config.KafkaExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.KafkaExporterConfig)(nil), (*KafkaExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_KafkaExporterConfig_To_v1alpha1_KafkaExporterConfig(a.(*config.KafkaExporterConfig), b.(*KafkaExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KafkaSASLConfig)(nil), (*config.KafkaSASLConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KafkaSASLConfig_To_config_KafkaSASLConfig(a.(*KafkaSASLConfig), b.(*config.KafkaSASLConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.KafkaSASLConfig)(nil), (*KafkaSASLConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_KafkaSASLConfig_To_v1alpha1_KafkaSASLConfig(a.(*config.KafkaSASLConfig), b.(*KafkaSASLConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KafkaTopicsConfig)(nil), (*config.KafkaTopicsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KafkaTopicsConfig_To_config_KafkaTopicsConfig(a.(*KafkaTopicsConfig), b.(*config.KafkaTopicsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.KafkaTopicsConfig)(nil), (*KafkaTopicsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_KafkaTopicsConfig_To_v1alpha1_KafkaTopicsConfig(a.(*config.KafkaTopicsConfig), b.(*KafkaTopicsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubernetesObjectConfig)(nil), (*config.KubernetesObjectConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubernetesObjectConfig_To_config_KubernetesObjectConfig(a.(*KubernetesObjectConfig), b.(*config.KubernetesObjectConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.KubernetesObjectConfig)(nil), (*KubernetesObjectConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_KubernetesObjectConfig_To_v1alpha1_KubernetesObjectConfig(a.(*config.KubernetesObjectConfig), b.(*KubernetesObjectConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LatencyPolicyConfig)(nil), (*config.LatencyPolicyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LatencyPolicyConfig_To_config_LatencyPolicyConfig(a.(*LatencyPolicyConfig), b.(*config.LatencyPolicyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LatencyPolicyConfig)(nil), (*LatencyPolicyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LatencyPolicyConfig_To_v1alpha1_LatencyPolicyConfig(a.(*config.LatencyPolicyConfig), b.(*LatencyPolicyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogsFilterConfig)(nil), (*config.LogsFilterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogsFilterConfig_To_config_LogsFilterConfig(a.(*LogsFilterConfig), b.(*config.LogsFilterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LogsFilterConfig)(nil), (*LogsFilterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LogsFilterConfig_To_v1alpha1_LogsFilterConfig(a.(*config.LogsFilterConfig), b.(*LogsFilterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricsFilterConfig)(nil), (*config.MetricsFilterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricsFilterConfig_To_config_MetricsFilterConfig(a.(*MetricsFilterConfig), b.(*config.MetricsFilterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MetricsFilterConfig)(nil), (*MetricsFilterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MetricsFilterConfig_To_v1alpha1_MetricsFilterConfig(a.(*config.MetricsFilterConfig), b.(*MetricsFilterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricsScrapeConfig)(nil), (*config.MetricsScrapeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricsScrapeConfig_To_config_MetricsScrapeConfig(a.(*MetricsScrapeConfig), b.(*config.MetricsScrapeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MetricsScrapeConfig)(nil), (*MetricsScrapeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MetricsScrapeConfig_To_v1alpha1_MetricsScrapeConfig(a.(*config.MetricsScrapeConfig), b.(*MetricsScrapeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedOTLPGRPCExporterConfig)(nil), (*config.NamedOTLPGRPCExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedOTLPGRPCExporterConfig_To_config_NamedOTLPGRPCExporterConfig(a.(*NamedOTLPGRPCExporterConfig), b.(*config.NamedOTLPGRPCExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamedOTLPGRPCExporterConfig)(nil), (*NamedOTLPGRPCExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamedOTLPGRPCExporterConfig_To_v1alpha1_NamedOTLPGRPCExporterConfig(a.(*config.NamedOTLPGRPCExporterConfig), b.(*NamedOTLPGRPCExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedOTLPHTTPExporterConfig)(nil), (*config.NamedOTLPHTTPExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedOTLPHTTPExporterConfig_To_config_NamedOTLPHTTPExporterConfig(a.(*NamedOTLPHTTPExporterConfig), b.(*config.NamedOTLPHTTPExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamedOTLPHTTPExporterConfig)(nil), (*NamedOTLPHTTPExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamedOTLPHTTPExporterConfig_To_v1alpha1_NamedOTLPHTTPExporterConfig(a.(*config.NamedOTLPHTTPExporterConfig), b.(*NamedOTLPHTTPExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OTLPGRPCExporterConfig)(nil), (*config.OTLPGRPCExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(a.(*OTLPGRPCExporterConfig), b.(*config.OTLPGRPCExporterConfig), scope)
 	}); err != nil {
@@ -101,6 +294,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PipelineConfig)(nil), (*config.PipelineConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PipelineConfig_To_config_PipelineConfig(a.(*PipelineConfig), b.(*config.PipelineConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PipelineConfig)(nil), (*PipelineConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PipelineConfig_To_v1alpha1_PipelineConfig(a.(*config.PipelineConfig), b.(*PipelineConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProbabilisticSamplerConfig)(nil), (*config.ProbabilisticSamplerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProbabilisticSamplerConfig_To_config_ProbabilisticSamplerConfig(a.(*ProbabilisticSamplerConfig), b.(*config.ProbabilisticSamplerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProbabilisticSamplerConfig)(nil), (*ProbabilisticSamplerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProbabilisticSamplerConfig_To_v1alpha1_ProbabilisticSamplerConfig(a.(*config.ProbabilisticSamplerConfig), b.(*ProbabilisticSamplerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusRemoteWriteExporterConfig)(nil), (*config.PrometheusRemoteWriteExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrometheusRemoteWriteExporterConfig_To_config_PrometheusRemoteWriteExporterConfig(a.(*PrometheusRemoteWriteExporterConfig), b.(*config.PrometheusRemoteWriteExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PrometheusRemoteWriteExporterConfig)(nil), (*PrometheusRemoteWriteExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PrometheusRemoteWriteExporterConfig_To_v1alpha1_PrometheusRemoteWriteExporterConfig(a.(*config.PrometheusRemoteWriteExporterConfig), b.(*PrometheusRemoteWriteExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusRemoteWriteWALConfig)(nil), (*config.PrometheusRemoteWriteWALConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrometheusRemoteWriteWALConfig_To_config_PrometheusRemoteWriteWALConfig(a.(*PrometheusRemoteWriteWALConfig), b.(*config.PrometheusRemoteWriteWALConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PrometheusRemoteWriteWALConfig)(nil), (*PrometheusRemoteWriteWALConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PrometheusRemoteWriteWALConfig_To_v1alpha1_PrometheusRemoteWriteWALConfig(a.(*config.PrometheusRemoteWriteWALConfig), b.(*PrometheusRemoteWriteWALConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RelabelConfig)(nil), (*config.RelabelConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RelabelConfig_To_config_RelabelConfig(a.(*RelabelConfig), b.(*config.RelabelConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RelabelConfig)(nil), (*RelabelConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RelabelConfig_To_v1alpha1_RelabelConfig(a.(*config.RelabelConfig), b.(*RelabelConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceAttributesConfig)(nil), (*config.ResourceAttributesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceAttributesConfig_To_config_ResourceAttributesConfig(a.(*ResourceAttributesConfig), b.(*config.ResourceAttributesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ResourceAttributesConfig)(nil), (*ResourceAttributesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ResourceAttributesConfig_To_v1alpha1_ResourceAttributesConfig(a.(*config.ResourceAttributesConfig), b.(*ResourceAttributesConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceReference)(nil), (*config.ResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourceReference_To_config_ResourceReference(a.(*ResourceReference), b.(*config.ResourceReference), scope)
 	}); err != nil {
@@ -131,6 +384,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SamplingConfig)(nil), (*config.SamplingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SamplingConfig_To_config_SamplingConfig(a.(*SamplingConfig), b.(*config.SamplingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SamplingConfig)(nil), (*SamplingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SamplingConfig_To_v1alpha1_SamplingConfig(a.(*config.SamplingConfig), b.(*SamplingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScalingConfig)(nil), (*config.ScalingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScalingConfig_To_config_ScalingConfig(a.(*ScalingConfig), b.(*config.ScalingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScalingConfig)(nil), (*ScalingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScalingConfig_To_v1alpha1_ScalingConfig(a.(*config.ScalingConfig), b.(*ScalingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SendingQueueConfig)(nil), (*config.SendingQueueConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(a.(*SendingQueueConfig), b.(*config.SendingQueueConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SendingQueueConfig)(nil), (*SendingQueueConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(a.(*config.SendingQueueConfig), b.(*SendingQueueConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StatusCodePolicyConfig)(nil), (*config.StatusCodePolicyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StatusCodePolicyConfig_To_config_StatusCodePolicyConfig(a.(*StatusCodePolicyConfig), b.(*config.StatusCodePolicyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StatusCodePolicyConfig)(nil), (*StatusCodePolicyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StatusCodePolicyConfig_To_v1alpha1_StatusCodePolicyConfig(a.(*config.StatusCodePolicyConfig), b.(*StatusCodePolicyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StringAttributePolicyConfig)(nil), (*config.StringAttributePolicyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StringAttributePolicyConfig_To_config_StringAttributePolicyConfig(a.(*StringAttributePolicyConfig), b.(*config.StringAttributePolicyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StringAttributePolicyConfig)(nil), (*StringAttributePolicyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StringAttributePolicyConfig_To_v1alpha1_StringAttributePolicyConfig(a.(*config.StringAttributePolicyConfig), b.(*StringAttributePolicyConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TLSConfig)(nil), (*config.TLSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TLSConfig_To_config_TLSConfig(a.(*TLSConfig), b.(*config.TLSConfig), scope)
 	}); err != nil {
@@ -141,9 +444,107 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TailSamplingConfig)(nil), (*config.TailSamplingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TailSamplingConfig_To_config_TailSamplingConfig(a.(*TailSamplingConfig), b.(*config.TailSamplingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TailSamplingConfig)(nil), (*TailSamplingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TailSamplingConfig_To_v1alpha1_TailSamplingConfig(a.(*config.TailSamplingConfig), b.(*TailSamplingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TailSamplingPolicy)(nil), (*config.TailSamplingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TailSamplingPolicy_To_config_TailSamplingPolicy(a.(*TailSamplingPolicy), b.(*config.TailSamplingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TailSamplingPolicy)(nil), (*TailSamplingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TailSamplingPolicy_To_v1alpha1_TailSamplingPolicy(a.(*config.TailSamplingPolicy), b.(*TailSamplingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetAllocatorScalingConfig)(nil), (*config.TargetAllocatorScalingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetAllocatorScalingConfig_To_config_TargetAllocatorScalingConfig(a.(*TargetAllocatorScalingConfig), b.(*config.TargetAllocatorScalingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetAllocatorScalingConfig)(nil), (*TargetAllocatorScalingConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetAllocatorScalingConfig_To_v1alpha1_TargetAllocatorScalingConfig(a.(*config.TargetAllocatorScalingConfig), b.(*TargetAllocatorScalingConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TracesFilterConfig)(nil), (*config.TracesFilterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TracesFilterConfig_To_config_TracesFilterConfig(a.(*TracesFilterConfig), b.(*config.TracesFilterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TracesFilterConfig)(nil), (*TracesFilterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TracesFilterConfig_To_v1alpha1_TracesFilterConfig(a.(*config.TracesFilterConfig), b.(*TracesFilterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TracesPipelineConfig)(nil), (*config.TracesPipelineConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TracesPipelineConfig_To_config_TracesPipelineConfig(a.(*TracesPipelineConfig), b.(*config.TracesPipelineConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TracesPipelineConfig)(nil), (*TracesPipelineConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TracesPipelineConfig_To_v1alpha1_TracesPipelineConfig(a.(*config.TracesPipelineConfig), b.(*TracesPipelineConfig), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AutoscalingConfig_To_config_AutoscalingConfig(in *AutoscalingConfig, out *config.AutoscalingConfig, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.TargetCPUUtilization = (*int32)(unsafe.Pointer(in.TargetCPUUtilization))
+	out.TargetMemoryUtilization = (*int32)(unsafe.Pointer(in.TargetMemoryUtilization))
+	return nil
+}
+
+// Convert_v1alpha1_AutoscalingConfig_To_config_AutoscalingConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AutoscalingConfig_To_config_AutoscalingConfig(in *AutoscalingConfig, out *config.AutoscalingConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AutoscalingConfig_To_config_AutoscalingConfig(in, out, s)
+}
+
+func autoConvert_config_AutoscalingConfig_To_v1alpha1_AutoscalingConfig(in *config.AutoscalingConfig, out *AutoscalingConfig, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.TargetCPUUtilization = (*int32)(unsafe.Pointer(in.TargetCPUUtilization))
+	out.TargetMemoryUtilization = (*int32)(unsafe.Pointer(in.TargetMemoryUtilization))
+	return nil
+}
+
+// Convert_config_AutoscalingConfig_To_v1alpha1_AutoscalingConfig is an autogenerated conversion function.
+func Convert_config_AutoscalingConfig_To_v1alpha1_AutoscalingConfig(in *config.AutoscalingConfig, out *AutoscalingConfig, s conversion.Scope) error {
+	return autoConvert_config_AutoscalingConfig_To_v1alpha1_AutoscalingConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CertificateStatus_To_config_CertificateStatus(in *CertificateStatus, out *config.CertificateStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NotAfter = in.NotAfter
 	return nil
 }
 
+// Convert_v1alpha1_CertificateStatus_To_config_CertificateStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CertificateStatus_To_config_CertificateStatus(in *CertificateStatus, out *config.CertificateStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificateStatus_To_config_CertificateStatus(in, out, s)
+}
+
+func autoConvert_config_CertificateStatus_To_v1alpha1_CertificateStatus(in *config.CertificateStatus, out *CertificateStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NotAfter = in.NotAfter
+	return nil
+}
+
+// Convert_config_CertificateStatus_To_v1alpha1_CertificateStatus is an autogenerated conversion function.
+func Convert_config_CertificateStatus_To_v1alpha1_CertificateStatus(in *config.CertificateStatus, out *CertificateStatus, s conversion.Scope) error {
+	return autoConvert_config_CertificateStatus_To_v1alpha1_CertificateStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_CollectorConfig_To_config_CollectorConfig(in *CollectorConfig, out *config.CollectorConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
@@ -172,6 +573,28 @@ func autoConvert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(in *
 	if err := Convert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(&in.Exporters, &out.Exporters, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(&in.Pipelines, &out.Pipelines, s); err != nil {
+		return err
+	}
+	out.KubernetesObjects = *(*[]config.KubernetesObjectConfig)(unsafe.Pointer(&in.KubernetesObjects))
+	if err := Convert_v1alpha1_EventsConfig_To_config_EventsConfig(&in.Events, &out.Events, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ResourceAttributesConfig_To_config_ResourceAttributesConfig(&in.ResourceAttributes, &out.ResourceAttributes, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SamplingConfig_To_config_SamplingConfig(&in.Sampling, &out.Sampling, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_FiltersConfig_To_config_FiltersConfig(&in.Filters, &out.Filters, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CollectorStorageConfig_To_config_CollectorStorageConfig(&in.Storage, &out.Storage, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ScalingConfig_To_config_ScalingConfig(&in.Scaling, &out.Scaling, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(&in.Logs, &out.Logs, s); err != nil {
 		return err
 	}
@@ -190,20 +613,42 @@ func autoConvert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in *
 	if err := Convert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(&in.Exporters, &out.Exporters, s); err != nil {
 		return err
 	}
-	if err := Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(&in.Logs, &out.Logs, s); err != nil {
+	if err := Convert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(&in.Pipelines, &out.Pipelines, s); err != nil {
 		return err
 	}
-	if err := Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(&in.Metrics, &out.Metrics, s); err != nil {
+	out.KubernetesObjects = *(*[]KubernetesObjectConfig)(unsafe.Pointer(&in.KubernetesObjects))
+	if err := Convert_config_EventsConfig_To_v1alpha1_EventsConfig(&in.Events, &out.Events, s); err != nil {
 		return err
 	}
-	return nil
-}
-
-// Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec is an autogenerated conversion function.
-func Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in *config.CollectorConfigSpec, out *CollectorConfigSpec, s conversion.Scope) error {
-	return autoConvert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in, out, s)
-}
-
+	if err := Convert_config_ResourceAttributesConfig_To_v1alpha1_ResourceAttributesConfig(&in.ResourceAttributes, &out.ResourceAttributes, s); err != nil {
+		return err
+	}
+	if err := Convert_config_SamplingConfig_To_v1alpha1_SamplingConfig(&in.Sampling, &out.Sampling, s); err != nil {
+		return err
+	}
+	if err := Convert_config_FiltersConfig_To_v1alpha1_FiltersConfig(&in.Filters, &out.Filters, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CollectorStorageConfig_To_v1alpha1_CollectorStorageConfig(&in.Storage, &out.Storage, s); err != nil {
+		return err
+	}
+	if err := Convert_config_ScalingConfig_To_v1alpha1_ScalingConfig(&in.Scaling, &out.Scaling, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(&in.Metrics, &out.Metrics, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec is an autogenerated conversion function.
+func Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in *config.CollectorConfigSpec, out *CollectorConfigSpec, s conversion.Scope) error {
+	return autoConvert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(in *CollectorExportersConfig, out *config.CollectorExportersConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(&in.OTLPGRPCExporter, &out.OTLPGRPCExporter, s); err != nil {
 		return err
@@ -214,6 +659,14 @@ func autoConvert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersC
 	if err := Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(&in.DebugExporter, &out.DebugExporter, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_PrometheusRemoteWriteExporterConfig_To_config_PrometheusRemoteWriteExporterConfig(&in.PrometheusRemoteWriteExporter, &out.PrometheusRemoteWriteExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_KafkaExporterConfig_To_config_KafkaExporterConfig(&in.KafkaExporter, &out.KafkaExporter, s); err != nil {
+		return err
+	}
+	out.NamedOTLPGRPCExporters = *(*[]config.NamedOTLPGRPCExporterConfig)(unsafe.Pointer(&in.NamedOTLPGRPCExporters))
+	out.NamedOTLPHTTPExporters = *(*[]config.NamedOTLPHTTPExporterConfig)(unsafe.Pointer(&in.NamedOTLPHTTPExporters))
 	return nil
 }
 
@@ -232,6 +685,14 @@ func autoConvert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersC
 	if err := Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(&in.DebugExporter, &out.DebugExporter, s); err != nil {
 		return err
 	}
+	if err := Convert_config_PrometheusRemoteWriteExporterConfig_To_v1alpha1_PrometheusRemoteWriteExporterConfig(&in.PrometheusRemoteWriteExporter, &out.PrometheusRemoteWriteExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_config_KafkaExporterConfig_To_v1alpha1_KafkaExporterConfig(&in.KafkaExporter, &out.KafkaExporter, s); err != nil {
+		return err
+	}
+	out.NamedOTLPGRPCExporters = *(*[]NamedOTLPGRPCExporterConfig)(unsafe.Pointer(&in.NamedOTLPGRPCExporters))
+	out.NamedOTLPHTTPExporters = *(*[]NamedOTLPHTTPExporterConfig)(unsafe.Pointer(&in.NamedOTLPHTTPExporters))
 	return nil
 }
 
@@ -257,51 +718,533 @@ func autoConvert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in *
 	return nil
 }
 
-// Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig is an autogenerated conversion function.
-func Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in *config.CollectorLogsConfig, out *CollectorLogsConfig, s conversion.Scope) error {
-	return autoConvert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in, out, s)
+// Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig is an autogenerated conversion function.
+func Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in *config.CollectorLogsConfig, out *CollectorLogsConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in *CollectorMetricsConfig, out *config.CollectorMetricsConfig, s conversion.Scope) error {
+	out.Level = config.MetricsVerbosityLevel(in.Level)
+	if err := Convert_v1alpha1_MetricsScrapeConfig_To_config_MetricsScrapeConfig(&in.Scrape, &out.Scrape, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in *CollectorMetricsConfig, out *config.CollectorMetricsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in *config.CollectorMetricsConfig, out *CollectorMetricsConfig, s conversion.Scope) error {
+	out.Level = MetricsVerbosityLevel(in.Level)
+	if err := Convert_config_MetricsScrapeConfig_To_v1alpha1_MetricsScrapeConfig(&in.Scrape, &out.Scrape, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig is an autogenerated conversion function.
+func Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in *config.CollectorMetricsConfig, out *CollectorMetricsConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(in *CollectorPipelinesConfig, out *config.CollectorPipelinesConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_PipelineConfig_To_config_PipelineConfig(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PipelineConfig_To_config_PipelineConfig(&in.Events, &out.Events, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PipelineConfig_To_config_PipelineConfig(&in.Metrics, &out.Metrics, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TracesPipelineConfig_To_config_TracesPipelineConfig(&in.Traces, &out.Traces, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(in *CollectorPipelinesConfig, out *config.CollectorPipelinesConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(in *config.CollectorPipelinesConfig, out *CollectorPipelinesConfig, s conversion.Scope) error {
+	if err := Convert_config_PipelineConfig_To_v1alpha1_PipelineConfig(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	if err := Convert_config_PipelineConfig_To_v1alpha1_PipelineConfig(&in.Events, &out.Events, s); err != nil {
+		return err
+	}
+	if err := Convert_config_PipelineConfig_To_v1alpha1_PipelineConfig(&in.Metrics, &out.Metrics, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TracesPipelineConfig_To_v1alpha1_TracesPipelineConfig(&in.Traces, &out.Traces, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig is an autogenerated conversion function.
+func Convert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(in *config.CollectorPipelinesConfig, out *CollectorPipelinesConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorScalingConfig_To_config_CollectorScalingConfig(in *CollectorScalingConfig, out *config.CollectorScalingConfig, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Autoscaling = (*config.AutoscalingConfig)(unsafe.Pointer(in.Autoscaling))
+	return nil
+}
+
+// Convert_v1alpha1_CollectorScalingConfig_To_config_CollectorScalingConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorScalingConfig_To_config_CollectorScalingConfig(in *CollectorScalingConfig, out *config.CollectorScalingConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorScalingConfig_To_config_CollectorScalingConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorScalingConfig_To_v1alpha1_CollectorScalingConfig(in *config.CollectorScalingConfig, out *CollectorScalingConfig, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Autoscaling = (*AutoscalingConfig)(unsafe.Pointer(in.Autoscaling))
+	return nil
+}
+
+// Convert_config_CollectorScalingConfig_To_v1alpha1_CollectorScalingConfig is an autogenerated conversion function.
+func Convert_config_CollectorScalingConfig_To_v1alpha1_CollectorScalingConfig(in *config.CollectorScalingConfig, out *CollectorScalingConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorScalingConfig_To_v1alpha1_CollectorScalingConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorStatus_To_config_CollectorStatus(in *CollectorStatus, out *config.CollectorStatus, s conversion.Scope) error {
+	out.Pipelines = *(*[]string)(unsafe.Pointer(&in.Pipelines))
+	out.Exporters = *(*[]config.ExporterStatus)(unsafe.Pointer(&in.Exporters))
+	out.ConfigHash = in.ConfigHash
+	out.CollectorVersion = in.CollectorVersion
+	out.TargetAllocatorVersion = in.TargetAllocatorVersion
+	out.Certificates = *(*[]config.CertificateStatus)(unsafe.Pointer(&in.Certificates))
+	return nil
+}
+
+// Convert_v1alpha1_CollectorStatus_To_config_CollectorStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorStatus_To_config_CollectorStatus(in *CollectorStatus, out *config.CollectorStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorStatus_To_config_CollectorStatus(in, out, s)
+}
+
+func autoConvert_config_CollectorStatus_To_v1alpha1_CollectorStatus(in *config.CollectorStatus, out *CollectorStatus, s conversion.Scope) error {
+	out.Pipelines = *(*[]string)(unsafe.Pointer(&in.Pipelines))
+	out.Exporters = *(*[]ExporterStatus)(unsafe.Pointer(&in.Exporters))
+	out.ConfigHash = in.ConfigHash
+	out.CollectorVersion = in.CollectorVersion
+	out.TargetAllocatorVersion = in.TargetAllocatorVersion
+	out.Certificates = *(*[]CertificateStatus)(unsafe.Pointer(&in.Certificates))
+	return nil
+}
+
+// Convert_config_CollectorStatus_To_v1alpha1_CollectorStatus is an autogenerated conversion function.
+func Convert_config_CollectorStatus_To_v1alpha1_CollectorStatus(in *config.CollectorStatus, out *CollectorStatus, s conversion.Scope) error {
+	return autoConvert_config_CollectorStatus_To_v1alpha1_CollectorStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorStorageConfig_To_config_CollectorStorageConfig(in *CollectorStorageConfig, out *config.CollectorStorageConfig, s conversion.Scope) error {
+	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
+	out.StorageClassName = (*string)(unsafe.Pointer(in.StorageClassName))
+	return nil
+}
+
+// Convert_v1alpha1_CollectorStorageConfig_To_config_CollectorStorageConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorStorageConfig_To_config_CollectorStorageConfig(in *CollectorStorageConfig, out *config.CollectorStorageConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorStorageConfig_To_config_CollectorStorageConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorStorageConfig_To_v1alpha1_CollectorStorageConfig(in *config.CollectorStorageConfig, out *CollectorStorageConfig, s conversion.Scope) error {
+	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
+	out.StorageClassName = (*string)(unsafe.Pointer(in.StorageClassName))
+	return nil
+}
+
+// Convert_config_CollectorStorageConfig_To_v1alpha1_CollectorStorageConfig is an autogenerated conversion function.
+func Convert_config_CollectorStorageConfig_To_v1alpha1_CollectorStorageConfig(in *config.CollectorStorageConfig, out *CollectorStorageConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorStorageConfig_To_v1alpha1_CollectorStorageConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in *DebugExporterConfig, out *config.DebugExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Verbosity = config.DebugExporterVerbosity(in.Verbosity)
+	return nil
+}
+
+// Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in *DebugExporterConfig, out *config.DebugExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in, out, s)
+}
+
+func autoConvert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in *config.DebugExporterConfig, out *DebugExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Verbosity = DebugExporterVerbosity(in.Verbosity)
+	return nil
+}
+
+// Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig is an autogenerated conversion function.
+func Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in *config.DebugExporterConfig, out *DebugExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EventsConfig_To_config_EventsConfig(in *EventsConfig, out *config.EventsConfig, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.ExcludeNamespaces = *(*[]string)(unsafe.Pointer(&in.ExcludeNamespaces))
+	out.Types = *(*[]config.EventType)(unsafe.Pointer(&in.Types))
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	out.ExcludeReasons = *(*[]string)(unsafe.Pointer(&in.ExcludeReasons))
+	out.OnlyNewEvents = (*bool)(unsafe.Pointer(in.OnlyNewEvents))
+	return nil
+}
+
+// Convert_v1alpha1_EventsConfig_To_config_EventsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EventsConfig_To_config_EventsConfig(in *EventsConfig, out *config.EventsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EventsConfig_To_config_EventsConfig(in, out, s)
+}
+
+func autoConvert_config_EventsConfig_To_v1alpha1_EventsConfig(in *config.EventsConfig, out *EventsConfig, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.ExcludeNamespaces = *(*[]string)(unsafe.Pointer(&in.ExcludeNamespaces))
+	out.Types = *(*[]EventType)(unsafe.Pointer(&in.Types))
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	out.ExcludeReasons = *(*[]string)(unsafe.Pointer(&in.ExcludeReasons))
+	out.OnlyNewEvents = (*bool)(unsafe.Pointer(in.OnlyNewEvents))
+	return nil
+}
+
+// Convert_config_EventsConfig_To_v1alpha1_EventsConfig is an autogenerated conversion function.
+func Convert_config_EventsConfig_To_v1alpha1_EventsConfig(in *config.EventsConfig, out *EventsConfig, s conversion.Scope) error {
+	return autoConvert_config_EventsConfig_To_v1alpha1_EventsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExporterStatus_To_config_ExporterStatus(in *ExporterStatus, out *config.ExporterStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Endpoint = in.Endpoint
+	return nil
+}
+
+// Convert_v1alpha1_ExporterStatus_To_config_ExporterStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ExporterStatus_To_config_ExporterStatus(in *ExporterStatus, out *config.ExporterStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExporterStatus_To_config_ExporterStatus(in, out, s)
+}
+
+func autoConvert_config_ExporterStatus_To_v1alpha1_ExporterStatus(in *config.ExporterStatus, out *ExporterStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Endpoint = in.Endpoint
+	return nil
+}
+
+// Convert_config_ExporterStatus_To_v1alpha1_ExporterStatus is an autogenerated conversion function.
+func Convert_config_ExporterStatus_To_v1alpha1_ExporterStatus(in *config.ExporterStatus, out *ExporterStatus, s conversion.Scope) error {
+	return autoConvert_config_ExporterStatus_To_v1alpha1_ExporterStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_FiltersConfig_To_config_FiltersConfig(in *FiltersConfig, out *config.FiltersConfig, s conversion.Scope) error {
+	out.ErrorMode = config.FilterErrorMode(in.ErrorMode)
+	if err := Convert_v1alpha1_LogsFilterConfig_To_config_LogsFilterConfig(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_MetricsFilterConfig_To_config_MetricsFilterConfig(&in.Metrics, &out.Metrics, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TracesFilterConfig_To_config_TracesFilterConfig(&in.Traces, &out.Traces, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_FiltersConfig_To_config_FiltersConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FiltersConfig_To_config_FiltersConfig(in *FiltersConfig, out *config.FiltersConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FiltersConfig_To_config_FiltersConfig(in, out, s)
+}
+
+func autoConvert_config_FiltersConfig_To_v1alpha1_FiltersConfig(in *config.FiltersConfig, out *FiltersConfig, s conversion.Scope) error {
+	out.ErrorMode = FilterErrorMode(in.ErrorMode)
+	if err := Convert_config_LogsFilterConfig_To_v1alpha1_LogsFilterConfig(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	if err := Convert_config_MetricsFilterConfig_To_v1alpha1_MetricsFilterConfig(&in.Metrics, &out.Metrics, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TracesFilterConfig_To_v1alpha1_TracesFilterConfig(&in.Traces, &out.Traces, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_FiltersConfig_To_v1alpha1_FiltersConfig is an autogenerated conversion function.
+func Convert_config_FiltersConfig_To_v1alpha1_FiltersConfig(in *config.FiltersConfig, out *FiltersConfig, s conversion.Scope) error {
+	return autoConvert_config_FiltersConfig_To_v1alpha1_FiltersConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_KafkaExporterConfig_To_config_KafkaExporterConfig(in *KafkaExporterConfig, out *config.KafkaExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Brokers = *(*[]string)(unsafe.Pointer(&in.Brokers))
+	if err := Convert_v1alpha1_KafkaTopicsConfig_To_config_KafkaTopicsConfig(&in.Topics, &out.Topics, s); err != nil {
+		return err
+	}
+	out.Encoding = config.KafkaEncoding(in.Encoding)
+	out.SASL = (*config.KafkaSASLConfig)(unsafe.Pointer(in.SASL))
+	out.TLS = (*config.TLSConfig)(unsafe.Pointer(in.TLS))
+	out.Timeout = time.Duration(in.Timeout)
+	if err := Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_KafkaExporterConfig_To_config_KafkaExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KafkaExporterConfig_To_config_KafkaExporterConfig(in *KafkaExporterConfig, out *config.KafkaExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KafkaExporterConfig_To_config_KafkaExporterConfig(in, out, s)
+}
+
+func autoConvert_config_KafkaExporterConfig_To_v1alpha1_KafkaExporterConfig(in *config.KafkaExporterConfig, out *KafkaExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Brokers = *(*[]string)(unsafe.Pointer(&in.Brokers))
+	if err := Convert_config_KafkaTopicsConfig_To_v1alpha1_KafkaTopicsConfig(&in.Topics, &out.Topics, s); err != nil {
+		return err
+	}
+	out.Encoding = KafkaEncoding(in.Encoding)
+	out.SASL = (*KafkaSASLConfig)(unsafe.Pointer(in.SASL))
+	out.TLS = (*TLSConfig)(unsafe.Pointer(in.TLS))
+	out.Timeout = time.Duration(in.Timeout)
+	if err := Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_KafkaExporterConfig_To_v1alpha1_KafkaExporterConfig is an autogenerated conversion function.
+func Convert_config_KafkaExporterConfig_To_v1alpha1_KafkaExporterConfig(in *config.KafkaExporterConfig, out *KafkaExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_KafkaExporterConfig_To_v1alpha1_KafkaExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_KafkaSASLConfig_To_config_KafkaSASLConfig(in *KafkaSASLConfig, out *config.KafkaSASLConfig, s conversion.Scope) error {
+	out.Mechanism = config.KafkaSASLMechanism(in.Mechanism)
+	out.Username = (*config.ResourceReference)(unsafe.Pointer(in.Username))
+	out.Password = (*config.ResourceReference)(unsafe.Pointer(in.Password))
+	return nil
+}
+
+// Convert_v1alpha1_KafkaSASLConfig_To_config_KafkaSASLConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KafkaSASLConfig_To_config_KafkaSASLConfig(in *KafkaSASLConfig, out *config.KafkaSASLConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KafkaSASLConfig_To_config_KafkaSASLConfig(in, out, s)
+}
+
+func autoConvert_config_KafkaSASLConfig_To_v1alpha1_KafkaSASLConfig(in *config.KafkaSASLConfig, out *KafkaSASLConfig, s conversion.Scope) error {
+	out.Mechanism = KafkaSASLMechanism(in.Mechanism)
+	out.Username = (*ResourceReference)(unsafe.Pointer(in.Username))
+	out.Password = (*ResourceReference)(unsafe.Pointer(in.Password))
+	return nil
+}
+
+// Convert_config_KafkaSASLConfig_To_v1alpha1_KafkaSASLConfig is an autogenerated conversion function.
+func Convert_config_KafkaSASLConfig_To_v1alpha1_KafkaSASLConfig(in *config.KafkaSASLConfig, out *KafkaSASLConfig, s conversion.Scope) error {
+	return autoConvert_config_KafkaSASLConfig_To_v1alpha1_KafkaSASLConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_KafkaTopicsConfig_To_config_KafkaTopicsConfig(in *KafkaTopicsConfig, out *config.KafkaTopicsConfig, s conversion.Scope) error {
+	out.Logs = in.Logs
+	out.Metrics = in.Metrics
+	out.Traces = in.Traces
+	return nil
+}
+
+// Convert_v1alpha1_KafkaTopicsConfig_To_config_KafkaTopicsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KafkaTopicsConfig_To_config_KafkaTopicsConfig(in *KafkaTopicsConfig, out *config.KafkaTopicsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KafkaTopicsConfig_To_config_KafkaTopicsConfig(in, out, s)
+}
+
+func autoConvert_config_KafkaTopicsConfig_To_v1alpha1_KafkaTopicsConfig(in *config.KafkaTopicsConfig, out *KafkaTopicsConfig, s conversion.Scope) error {
+	out.Logs = in.Logs
+	out.Metrics = in.Metrics
+	out.Traces = in.Traces
+	return nil
+}
+
+// Convert_config_KafkaTopicsConfig_To_v1alpha1_KafkaTopicsConfig is an autogenerated conversion function.
+func Convert_config_KafkaTopicsConfig_To_v1alpha1_KafkaTopicsConfig(in *config.KafkaTopicsConfig, out *KafkaTopicsConfig, s conversion.Scope) error {
+	return autoConvert_config_KafkaTopicsConfig_To_v1alpha1_KafkaTopicsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_KubernetesObjectConfig_To_config_KubernetesObjectConfig(in *KubernetesObjectConfig, out *config.KubernetesObjectConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Group = in.Group
+	out.Mode = config.KubernetesObjectMode(in.Mode)
+	out.Interval = time.Duration(in.Interval)
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.LabelSelector = in.LabelSelector
+	out.FieldSelector = in.FieldSelector
+	return nil
+}
+
+// Convert_v1alpha1_KubernetesObjectConfig_To_config_KubernetesObjectConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KubernetesObjectConfig_To_config_KubernetesObjectConfig(in *KubernetesObjectConfig, out *config.KubernetesObjectConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubernetesObjectConfig_To_config_KubernetesObjectConfig(in, out, s)
+}
+
+func autoConvert_config_KubernetesObjectConfig_To_v1alpha1_KubernetesObjectConfig(in *config.KubernetesObjectConfig, out *KubernetesObjectConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Group = in.Group
+	out.Mode = KubernetesObjectMode(in.Mode)
+	out.Interval = time.Duration(in.Interval)
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.LabelSelector = in.LabelSelector
+	out.FieldSelector = in.FieldSelector
+	return nil
+}
+
+// Convert_config_KubernetesObjectConfig_To_v1alpha1_KubernetesObjectConfig is an autogenerated conversion function.
+func Convert_config_KubernetesObjectConfig_To_v1alpha1_KubernetesObjectConfig(in *config.KubernetesObjectConfig, out *KubernetesObjectConfig, s conversion.Scope) error {
+	return autoConvert_config_KubernetesObjectConfig_To_v1alpha1_KubernetesObjectConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LatencyPolicyConfig_To_config_LatencyPolicyConfig(in *LatencyPolicyConfig, out *config.LatencyPolicyConfig, s conversion.Scope) error {
+	out.ThresholdMs = in.ThresholdMs
+	out.UpperThresholdMs = in.UpperThresholdMs
+	return nil
+}
+
+// Convert_v1alpha1_LatencyPolicyConfig_To_config_LatencyPolicyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LatencyPolicyConfig_To_config_LatencyPolicyConfig(in *LatencyPolicyConfig, out *config.LatencyPolicyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LatencyPolicyConfig_To_config_LatencyPolicyConfig(in, out, s)
+}
+
+func autoConvert_config_LatencyPolicyConfig_To_v1alpha1_LatencyPolicyConfig(in *config.LatencyPolicyConfig, out *LatencyPolicyConfig, s conversion.Scope) error {
+	out.ThresholdMs = in.ThresholdMs
+	out.UpperThresholdMs = in.UpperThresholdMs
+	return nil
+}
+
+// Convert_config_LatencyPolicyConfig_To_v1alpha1_LatencyPolicyConfig is an autogenerated conversion function.
+func Convert_config_LatencyPolicyConfig_To_v1alpha1_LatencyPolicyConfig(in *config.LatencyPolicyConfig, out *LatencyPolicyConfig, s conversion.Scope) error {
+	return autoConvert_config_LatencyPolicyConfig_To_v1alpha1_LatencyPolicyConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LogsFilterConfig_To_config_LogsFilterConfig(in *LogsFilterConfig, out *config.LogsFilterConfig, s conversion.Scope) error {
+	out.LogRecord = *(*[]string)(unsafe.Pointer(&in.LogRecord))
+	return nil
+}
+
+// Convert_v1alpha1_LogsFilterConfig_To_config_LogsFilterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LogsFilterConfig_To_config_LogsFilterConfig(in *LogsFilterConfig, out *config.LogsFilterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogsFilterConfig_To_config_LogsFilterConfig(in, out, s)
+}
+
+func autoConvert_config_LogsFilterConfig_To_v1alpha1_LogsFilterConfig(in *config.LogsFilterConfig, out *LogsFilterConfig, s conversion.Scope) error {
+	out.LogRecord = *(*[]string)(unsafe.Pointer(&in.LogRecord))
+	return nil
+}
+
+// Convert_config_LogsFilterConfig_To_v1alpha1_LogsFilterConfig is an autogenerated conversion function.
+func Convert_config_LogsFilterConfig_To_v1alpha1_LogsFilterConfig(in *config.LogsFilterConfig, out *LogsFilterConfig, s conversion.Scope) error {
+	return autoConvert_config_LogsFilterConfig_To_v1alpha1_LogsFilterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricsFilterConfig_To_config_MetricsFilterConfig(in *MetricsFilterConfig, out *config.MetricsFilterConfig, s conversion.Scope) error {
+	out.Metric = *(*[]string)(unsafe.Pointer(&in.Metric))
+	out.DataPoint = *(*[]string)(unsafe.Pointer(&in.DataPoint))
+	return nil
+}
+
+// Convert_v1alpha1_MetricsFilterConfig_To_config_MetricsFilterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_MetricsFilterConfig_To_config_MetricsFilterConfig(in *MetricsFilterConfig, out *config.MetricsFilterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricsFilterConfig_To_config_MetricsFilterConfig(in, out, s)
+}
+
+func autoConvert_config_MetricsFilterConfig_To_v1alpha1_MetricsFilterConfig(in *config.MetricsFilterConfig, out *MetricsFilterConfig, s conversion.Scope) error {
+	out.Metric = *(*[]string)(unsafe.Pointer(&in.Metric))
+	out.DataPoint = *(*[]string)(unsafe.Pointer(&in.DataPoint))
+	return nil
+}
+
+// Convert_config_MetricsFilterConfig_To_v1alpha1_MetricsFilterConfig is an autogenerated conversion function.
+func Convert_config_MetricsFilterConfig_To_v1alpha1_MetricsFilterConfig(in *config.MetricsFilterConfig, out *MetricsFilterConfig, s conversion.Scope) error {
+	return autoConvert_config_MetricsFilterConfig_To_v1alpha1_MetricsFilterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricsScrapeConfig_To_config_MetricsScrapeConfig(in *MetricsScrapeConfig, out *config.MetricsScrapeConfig, s conversion.Scope) error {
+	out.Interval = time.Duration(in.Interval)
+	out.AllocationStrategy = config.AllocationStrategy(in.AllocationStrategy)
+	out.ServiceMonitorSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ServiceMonitorSelector))
+	out.PodMonitorSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.PodMonitorSelector))
+	out.ScrapeConfigSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ScrapeConfigSelector))
+	out.MetricRelabelConfigs = *(*[]config.RelabelConfig)(unsafe.Pointer(&in.MetricRelabelConfigs))
+	return nil
+}
+
+// Convert_v1alpha1_MetricsScrapeConfig_To_config_MetricsScrapeConfig is an autogenerated conversion function.
+func Convert_v1alpha1_MetricsScrapeConfig_To_config_MetricsScrapeConfig(in *MetricsScrapeConfig, out *config.MetricsScrapeConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricsScrapeConfig_To_config_MetricsScrapeConfig(in, out, s)
+}
+
+func autoConvert_config_MetricsScrapeConfig_To_v1alpha1_MetricsScrapeConfig(in *config.MetricsScrapeConfig, out *MetricsScrapeConfig, s conversion.Scope) error {
+	out.Interval = time.Duration(in.Interval)
+	out.AllocationStrategy = AllocationStrategy(in.AllocationStrategy)
+	out.ServiceMonitorSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ServiceMonitorSelector))
+	out.PodMonitorSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.PodMonitorSelector))
+	out.ScrapeConfigSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ScrapeConfigSelector))
+	out.MetricRelabelConfigs = *(*[]RelabelConfig)(unsafe.Pointer(&in.MetricRelabelConfigs))
+	return nil
+}
+
+// Convert_config_MetricsScrapeConfig_To_v1alpha1_MetricsScrapeConfig is an autogenerated conversion function.
+func Convert_config_MetricsScrapeConfig_To_v1alpha1_MetricsScrapeConfig(in *config.MetricsScrapeConfig, out *MetricsScrapeConfig, s conversion.Scope) error {
+	return autoConvert_config_MetricsScrapeConfig_To_v1alpha1_MetricsScrapeConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in *CollectorMetricsConfig, out *config.CollectorMetricsConfig, s conversion.Scope) error {
-	out.Level = config.MetricsVerbosityLevel(in.Level)
+func autoConvert_v1alpha1_NamedOTLPGRPCExporterConfig_To_config_NamedOTLPGRPCExporterConfig(in *NamedOTLPGRPCExporterConfig, out *config.NamedOTLPGRPCExporterConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(&in.OTLPGRPCExporterConfig, &out.OTLPGRPCExporterConfig, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in *CollectorMetricsConfig, out *config.CollectorMetricsConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in, out, s)
+// Convert_v1alpha1_NamedOTLPGRPCExporterConfig_To_config_NamedOTLPGRPCExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamedOTLPGRPCExporterConfig_To_config_NamedOTLPGRPCExporterConfig(in *NamedOTLPGRPCExporterConfig, out *config.NamedOTLPGRPCExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedOTLPGRPCExporterConfig_To_config_NamedOTLPGRPCExporterConfig(in, out, s)
 }
 
-func autoConvert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in *config.CollectorMetricsConfig, out *CollectorMetricsConfig, s conversion.Scope) error {
-	out.Level = MetricsVerbosityLevel(in.Level)
+func autoConvert_config_NamedOTLPGRPCExporterConfig_To_v1alpha1_NamedOTLPGRPCExporterConfig(in *config.NamedOTLPGRPCExporterConfig, out *NamedOTLPGRPCExporterConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(&in.OTLPGRPCExporterConfig, &out.OTLPGRPCExporterConfig, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig is an autogenerated conversion function.
-func Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in *config.CollectorMetricsConfig, out *CollectorMetricsConfig, s conversion.Scope) error {
-	return autoConvert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in, out, s)
+// Convert_config_NamedOTLPGRPCExporterConfig_To_v1alpha1_NamedOTLPGRPCExporterConfig is an autogenerated conversion function.
+func Convert_config_NamedOTLPGRPCExporterConfig_To_v1alpha1_NamedOTLPGRPCExporterConfig(in *config.NamedOTLPGRPCExporterConfig, out *NamedOTLPGRPCExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_NamedOTLPGRPCExporterConfig_To_v1alpha1_NamedOTLPGRPCExporterConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in *DebugExporterConfig, out *config.DebugExporterConfig, s conversion.Scope) error {
-	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.Verbosity = config.DebugExporterVerbosity(in.Verbosity)
+func autoConvert_v1alpha1_NamedOTLPHTTPExporterConfig_To_config_NamedOTLPHTTPExporterConfig(in *NamedOTLPHTTPExporterConfig, out *config.NamedOTLPHTTPExporterConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(&in.OTLPHTTPExporterConfig, &out.OTLPHTTPExporterConfig, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig is an autogenerated conversion function.
-func Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in *DebugExporterConfig, out *config.DebugExporterConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in, out, s)
+// Convert_v1alpha1_NamedOTLPHTTPExporterConfig_To_config_NamedOTLPHTTPExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamedOTLPHTTPExporterConfig_To_config_NamedOTLPHTTPExporterConfig(in *NamedOTLPHTTPExporterConfig, out *config.NamedOTLPHTTPExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedOTLPHTTPExporterConfig_To_config_NamedOTLPHTTPExporterConfig(in, out, s)
 }
 
-func autoConvert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in *config.DebugExporterConfig, out *DebugExporterConfig, s conversion.Scope) error {
-	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.Verbosity = DebugExporterVerbosity(in.Verbosity)
+func autoConvert_config_NamedOTLPHTTPExporterConfig_To_v1alpha1_NamedOTLPHTTPExporterConfig(in *config.NamedOTLPHTTPExporterConfig, out *NamedOTLPHTTPExporterConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(&in.OTLPHTTPExporterConfig, &out.OTLPHTTPExporterConfig, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig is an autogenerated conversion function.
-func Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in *config.DebugExporterConfig, out *DebugExporterConfig, s conversion.Scope) error {
-	return autoConvert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in, out, s)
+// Convert_config_NamedOTLPHTTPExporterConfig_To_v1alpha1_NamedOTLPHTTPExporterConfig is an autogenerated conversion function.
+func Convert_config_NamedOTLPHTTPExporterConfig_To_v1alpha1_NamedOTLPHTTPExporterConfig(in *config.NamedOTLPHTTPExporterConfig, out *NamedOTLPHTTPExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_NamedOTLPHTTPExporterConfig_To_v1alpha1_NamedOTLPHTTPExporterConfig(in, out, s)
 }
 
 func autoConvert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(in *OTLPGRPCExporterConfig, out *config.OTLPGRPCExporterConfig, s conversion.Scope) error {
@@ -315,6 +1258,9 @@ func autoConvert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfi
 	if err := Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(&in.SendingQueue, &out.SendingQueue, s); err != nil {
+		return err
+	}
 	out.Compression = config.Compression(in.Compression)
 	return nil
 }
@@ -335,6 +1281,9 @@ func autoConvert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfi
 	if err := Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
 		return err
 	}
+	if err := Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(&in.SendingQueue, &out.SendingQueue, s); err != nil {
+		return err
+	}
 	out.Compression = Compression(in.Compression)
 	return nil
 }
@@ -360,6 +1309,9 @@ func autoConvert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfi
 	if err := Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(&in.SendingQueue, &out.SendingQueue, s); err != nil {
+		return err
+	}
 	out.Compression = config.Compression(in.Compression)
 	return nil
 }
@@ -385,6 +1337,9 @@ func autoConvert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfi
 	if err := Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
 		return err
 	}
+	if err := Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(&in.SendingQueue, &out.SendingQueue, s); err != nil {
+		return err
+	}
 	out.Compression = Compression(in.Compression)
 	return nil
 }
@@ -394,6 +1349,172 @@ func Convert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(in
 	return autoConvert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_PipelineConfig_To_config_PipelineConfig(in *PipelineConfig, out *config.PipelineConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Exporters = *(*[]string)(unsafe.Pointer(&in.Exporters))
+	return nil
+}
+
+// Convert_v1alpha1_PipelineConfig_To_config_PipelineConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PipelineConfig_To_config_PipelineConfig(in *PipelineConfig, out *config.PipelineConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PipelineConfig_To_config_PipelineConfig(in, out, s)
+}
+
+func autoConvert_config_PipelineConfig_To_v1alpha1_PipelineConfig(in *config.PipelineConfig, out *PipelineConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Exporters = *(*[]string)(unsafe.Pointer(&in.Exporters))
+	return nil
+}
+
+// Convert_config_PipelineConfig_To_v1alpha1_PipelineConfig is an autogenerated conversion function.
+func Convert_config_PipelineConfig_To_v1alpha1_PipelineConfig(in *config.PipelineConfig, out *PipelineConfig, s conversion.Scope) error {
+	return autoConvert_config_PipelineConfig_To_v1alpha1_PipelineConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProbabilisticSamplerConfig_To_config_ProbabilisticSamplerConfig(in *ProbabilisticSamplerConfig, out *config.ProbabilisticSamplerConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.SamplingPercentage = in.SamplingPercentage
+	return nil
+}
+
+// Convert_v1alpha1_ProbabilisticSamplerConfig_To_config_ProbabilisticSamplerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ProbabilisticSamplerConfig_To_config_ProbabilisticSamplerConfig(in *ProbabilisticSamplerConfig, out *config.ProbabilisticSamplerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProbabilisticSamplerConfig_To_config_ProbabilisticSamplerConfig(in, out, s)
+}
+
+func autoConvert_config_ProbabilisticSamplerConfig_To_v1alpha1_ProbabilisticSamplerConfig(in *config.ProbabilisticSamplerConfig, out *ProbabilisticSamplerConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.SamplingPercentage = in.SamplingPercentage
+	return nil
+}
+
+// Convert_config_ProbabilisticSamplerConfig_To_v1alpha1_ProbabilisticSamplerConfig is an autogenerated conversion function.
+func Convert_config_ProbabilisticSamplerConfig_To_v1alpha1_ProbabilisticSamplerConfig(in *config.ProbabilisticSamplerConfig, out *ProbabilisticSamplerConfig, s conversion.Scope) error {
+	return autoConvert_config_ProbabilisticSamplerConfig_To_v1alpha1_ProbabilisticSamplerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrometheusRemoteWriteExporterConfig_To_config_PrometheusRemoteWriteExporterConfig(in *PrometheusRemoteWriteExporterConfig, out *config.PrometheusRemoteWriteExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Endpoint = in.Endpoint
+	out.TLS = (*config.TLSConfig)(unsafe.Pointer(in.TLS))
+	out.Token = (*config.ResourceReference)(unsafe.Pointer(in.Token))
+	out.Timeout = time.Duration(in.Timeout)
+	out.ExternalLabels = *(*map[string]string)(unsafe.Pointer(&in.ExternalLabels))
+	if err := Convert_v1alpha1_PrometheusRemoteWriteWALConfig_To_config_PrometheusRemoteWriteWALConfig(&in.WAL, &out.WAL, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PrometheusRemoteWriteExporterConfig_To_config_PrometheusRemoteWriteExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PrometheusRemoteWriteExporterConfig_To_config_PrometheusRemoteWriteExporterConfig(in *PrometheusRemoteWriteExporterConfig, out *config.PrometheusRemoteWriteExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrometheusRemoteWriteExporterConfig_To_config_PrometheusRemoteWriteExporterConfig(in, out, s)
+}
+
+func autoConvert_config_PrometheusRemoteWriteExporterConfig_To_v1alpha1_PrometheusRemoteWriteExporterConfig(in *config.PrometheusRemoteWriteExporterConfig, out *PrometheusRemoteWriteExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Endpoint = in.Endpoint
+	out.TLS = (*TLSConfig)(unsafe.Pointer(in.TLS))
+	out.Token = (*ResourceReference)(unsafe.Pointer(in.Token))
+	out.Timeout = time.Duration(in.Timeout)
+	out.ExternalLabels = *(*map[string]string)(unsafe.Pointer(&in.ExternalLabels))
+	if err := Convert_config_PrometheusRemoteWriteWALConfig_To_v1alpha1_PrometheusRemoteWriteWALConfig(&in.WAL, &out.WAL, s); err != nil {
+		return err
+	}
+	if err := Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_PrometheusRemoteWriteExporterConfig_To_v1alpha1_PrometheusRemoteWriteExporterConfig is an autogenerated conversion function.
+func Convert_config_PrometheusRemoteWriteExporterConfig_To_v1alpha1_PrometheusRemoteWriteExporterConfig(in *config.PrometheusRemoteWriteExporterConfig, out *PrometheusRemoteWriteExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_PrometheusRemoteWriteExporterConfig_To_v1alpha1_PrometheusRemoteWriteExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrometheusRemoteWriteWALConfig_To_config_PrometheusRemoteWriteWALConfig(in *PrometheusRemoteWriteWALConfig, out *config.PrometheusRemoteWriteWALConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.BufferSize = in.BufferSize
+	out.TruncateFrequency = time.Duration(in.TruncateFrequency)
+	return nil
+}
+
+// Convert_v1alpha1_PrometheusRemoteWriteWALConfig_To_config_PrometheusRemoteWriteWALConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PrometheusRemoteWriteWALConfig_To_config_PrometheusRemoteWriteWALConfig(in *PrometheusRemoteWriteWALConfig, out *config.PrometheusRemoteWriteWALConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrometheusRemoteWriteWALConfig_To_config_PrometheusRemoteWriteWALConfig(in, out, s)
+}
+
+func autoConvert_config_PrometheusRemoteWriteWALConfig_To_v1alpha1_PrometheusRemoteWriteWALConfig(in *config.PrometheusRemoteWriteWALConfig, out *PrometheusRemoteWriteWALConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.BufferSize = in.BufferSize
+	out.TruncateFrequency = time.Duration(in.TruncateFrequency)
+	return nil
+}
+
+// Convert_config_PrometheusRemoteWriteWALConfig_To_v1alpha1_PrometheusRemoteWriteWALConfig is an autogenerated conversion function.
+func Convert_config_PrometheusRemoteWriteWALConfig_To_v1alpha1_PrometheusRemoteWriteWALConfig(in *config.PrometheusRemoteWriteWALConfig, out *PrometheusRemoteWriteWALConfig, s conversion.Scope) error {
+	return autoConvert_config_PrometheusRemoteWriteWALConfig_To_v1alpha1_PrometheusRemoteWriteWALConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_RelabelConfig_To_config_RelabelConfig(in *RelabelConfig, out *config.RelabelConfig, s conversion.Scope) error {
+	out.SourceLabels = *(*[]string)(unsafe.Pointer(&in.SourceLabels))
+	out.Separator = (*string)(unsafe.Pointer(in.Separator))
+	out.TargetLabel = in.TargetLabel
+	out.Regex = in.Regex
+	out.Modulus = in.Modulus
+	out.Replacement = (*string)(unsafe.Pointer(in.Replacement))
+	out.Action = config.RelabelAction(in.Action)
+	return nil
+}
+
+// Convert_v1alpha1_RelabelConfig_To_config_RelabelConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RelabelConfig_To_config_RelabelConfig(in *RelabelConfig, out *config.RelabelConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RelabelConfig_To_config_RelabelConfig(in, out, s)
+}
+
+func autoConvert_config_RelabelConfig_To_v1alpha1_RelabelConfig(in *config.RelabelConfig, out *RelabelConfig, s conversion.Scope) error {
+	out.SourceLabels = *(*[]string)(unsafe.Pointer(&in.SourceLabels))
+	out.Separator = (*string)(unsafe.Pointer(in.Separator))
+	out.TargetLabel = in.TargetLabel
+	out.Regex = in.Regex
+	out.Modulus = in.Modulus
+	out.Replacement = (*string)(unsafe.Pointer(in.Replacement))
+	out.Action = RelabelAction(in.Action)
+	return nil
+}
+
+// Convert_config_RelabelConfig_To_v1alpha1_RelabelConfig is an autogenerated conversion function.
+func Convert_config_RelabelConfig_To_v1alpha1_RelabelConfig(in *config.RelabelConfig, out *RelabelConfig, s conversion.Scope) error {
+	return autoConvert_config_RelabelConfig_To_v1alpha1_RelabelConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceAttributesConfig_To_config_ResourceAttributesConfig(in *ResourceAttributesConfig, out *config.ResourceAttributesConfig, s conversion.Scope) error {
+	out.Static = *(*map[string]string)(unsafe.Pointer(&in.Static))
+	out.ShootLabels = *(*[]string)(unsafe.Pointer(&in.ShootLabels))
+	out.ShootAnnotations = *(*[]string)(unsafe.Pointer(&in.ShootAnnotations))
+	return nil
+}
+
+// Convert_v1alpha1_ResourceAttributesConfig_To_config_ResourceAttributesConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceAttributesConfig_To_config_ResourceAttributesConfig(in *ResourceAttributesConfig, out *config.ResourceAttributesConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceAttributesConfig_To_config_ResourceAttributesConfig(in, out, s)
+}
+
+func autoConvert_config_ResourceAttributesConfig_To_v1alpha1_ResourceAttributesConfig(in *config.ResourceAttributesConfig, out *ResourceAttributesConfig, s conversion.Scope) error {
+	out.Static = *(*map[string]string)(unsafe.Pointer(&in.Static))
+	out.ShootLabels = *(*[]string)(unsafe.Pointer(&in.ShootLabels))
+	out.ShootAnnotations = *(*[]string)(unsafe.Pointer(&in.ShootAnnotations))
+	return nil
+}
+
+// Convert_config_ResourceAttributesConfig_To_v1alpha1_ResourceAttributesConfig is an autogenerated conversion function.
+func Convert_config_ResourceAttributesConfig_To_v1alpha1_ResourceAttributesConfig(in *config.ResourceAttributesConfig, out *ResourceAttributesConfig, s conversion.Scope) error {
+	return autoConvert_config_ResourceAttributesConfig_To_v1alpha1_ResourceAttributesConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResourceReference_To_config_ResourceReference(in *ResourceReference, out *config.ResourceReference, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(&in.ResourceRef, &out.ResourceRef, s); err != nil {
 		return err
@@ -468,6 +1589,138 @@ func Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(in *co
 	return autoConvert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_SamplingConfig_To_config_SamplingConfig(in *SamplingConfig, out *config.SamplingConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ProbabilisticSamplerConfig_To_config_ProbabilisticSamplerConfig(&in.Probabilistic, &out.Probabilistic, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TailSamplingConfig_To_config_TailSamplingConfig(&in.TailSampling, &out.TailSampling, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SamplingConfig_To_config_SamplingConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SamplingConfig_To_config_SamplingConfig(in *SamplingConfig, out *config.SamplingConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SamplingConfig_To_config_SamplingConfig(in, out, s)
+}
+
+func autoConvert_config_SamplingConfig_To_v1alpha1_SamplingConfig(in *config.SamplingConfig, out *SamplingConfig, s conversion.Scope) error {
+	if err := Convert_config_ProbabilisticSamplerConfig_To_v1alpha1_ProbabilisticSamplerConfig(&in.Probabilistic, &out.Probabilistic, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TailSamplingConfig_To_v1alpha1_TailSamplingConfig(&in.TailSampling, &out.TailSampling, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_SamplingConfig_To_v1alpha1_SamplingConfig is an autogenerated conversion function.
+func Convert_config_SamplingConfig_To_v1alpha1_SamplingConfig(in *config.SamplingConfig, out *SamplingConfig, s conversion.Scope) error {
+	return autoConvert_config_SamplingConfig_To_v1alpha1_SamplingConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScalingConfig_To_config_ScalingConfig(in *ScalingConfig, out *config.ScalingConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CollectorScalingConfig_To_config_CollectorScalingConfig(&in.Collector, &out.Collector, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TargetAllocatorScalingConfig_To_config_TargetAllocatorScalingConfig(&in.TargetAllocator, &out.TargetAllocator, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ScalingConfig_To_config_ScalingConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ScalingConfig_To_config_ScalingConfig(in *ScalingConfig, out *config.ScalingConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScalingConfig_To_config_ScalingConfig(in, out, s)
+}
+
+func autoConvert_config_ScalingConfig_To_v1alpha1_ScalingConfig(in *config.ScalingConfig, out *ScalingConfig, s conversion.Scope) error {
+	if err := Convert_config_CollectorScalingConfig_To_v1alpha1_CollectorScalingConfig(&in.Collector, &out.Collector, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TargetAllocatorScalingConfig_To_v1alpha1_TargetAllocatorScalingConfig(&in.TargetAllocator, &out.TargetAllocator, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ScalingConfig_To_v1alpha1_ScalingConfig is an autogenerated conversion function.
+func Convert_config_ScalingConfig_To_v1alpha1_ScalingConfig(in *config.ScalingConfig, out *ScalingConfig, s conversion.Scope) error {
+	return autoConvert_config_ScalingConfig_To_v1alpha1_ScalingConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(in *SendingQueueConfig, out *config.SendingQueueConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.NumConsumers = in.NumConsumers
+	out.QueueSize = in.QueueSize
+	out.Storage = config.SendingQueueStorage(in.Storage)
+	return nil
+}
+
+// Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(in *SendingQueueConfig, out *config.SendingQueueConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(in, out, s)
+}
+
+func autoConvert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(in *config.SendingQueueConfig, out *SendingQueueConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.NumConsumers = in.NumConsumers
+	out.QueueSize = in.QueueSize
+	out.Storage = SendingQueueStorage(in.Storage)
+	return nil
+}
+
+// Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig is an autogenerated conversion function.
+func Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(in *config.SendingQueueConfig, out *SendingQueueConfig, s conversion.Scope) error {
+	return autoConvert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_StatusCodePolicyConfig_To_config_StatusCodePolicyConfig(in *StatusCodePolicyConfig, out *config.StatusCodePolicyConfig, s conversion.Scope) error {
+	out.StatusCodes = *(*[]string)(unsafe.Pointer(&in.StatusCodes))
+	return nil
+}
+
+// Convert_v1alpha1_StatusCodePolicyConfig_To_config_StatusCodePolicyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_StatusCodePolicyConfig_To_config_StatusCodePolicyConfig(in *StatusCodePolicyConfig, out *config.StatusCodePolicyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StatusCodePolicyConfig_To_config_StatusCodePolicyConfig(in, out, s)
+}
+
+func autoConvert_config_StatusCodePolicyConfig_To_v1alpha1_StatusCodePolicyConfig(in *config.StatusCodePolicyConfig, out *StatusCodePolicyConfig, s conversion.Scope) error {
+	out.StatusCodes = *(*[]string)(unsafe.Pointer(&in.StatusCodes))
+	return nil
+}
+
+// Convert_config_StatusCodePolicyConfig_To_v1alpha1_StatusCodePolicyConfig is an autogenerated conversion function.
+func Convert_config_StatusCodePolicyConfig_To_v1alpha1_StatusCodePolicyConfig(in *config.StatusCodePolicyConfig, out *StatusCodePolicyConfig, s conversion.Scope) error {
+	return autoConvert_config_StatusCodePolicyConfig_To_v1alpha1_StatusCodePolicyConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_StringAttributePolicyConfig_To_config_StringAttributePolicyConfig(in *StringAttributePolicyConfig, out *config.StringAttributePolicyConfig, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.EnabledRegexMatching = in.EnabledRegexMatching
+	out.InvertMatch = in.InvertMatch
+	return nil
+}
+
+// Convert_v1alpha1_StringAttributePolicyConfig_To_config_StringAttributePolicyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_StringAttributePolicyConfig_To_config_StringAttributePolicyConfig(in *StringAttributePolicyConfig, out *config.StringAttributePolicyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StringAttributePolicyConfig_To_config_StringAttributePolicyConfig(in, out, s)
+}
+
+func autoConvert_config_StringAttributePolicyConfig_To_v1alpha1_StringAttributePolicyConfig(in *config.StringAttributePolicyConfig, out *StringAttributePolicyConfig, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.EnabledRegexMatching = in.EnabledRegexMatching
+	out.InvertMatch = in.InvertMatch
+	return nil
+}
+
+// Convert_config_StringAttributePolicyConfig_To_v1alpha1_StringAttributePolicyConfig is an autogenerated conversion function.
+func Convert_config_StringAttributePolicyConfig_To_v1alpha1_StringAttributePolicyConfig(in *config.StringAttributePolicyConfig, out *StringAttributePolicyConfig, s conversion.Scope) error {
+	return autoConvert_config_StringAttributePolicyConfig_To_v1alpha1_StringAttributePolicyConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_TLSConfig_To_config_TLSConfig(in *TLSConfig, out *config.TLSConfig, s conversion.Scope) error {
 	out.InsecureSkipVerify = (*bool)(unsafe.Pointer(in.InsecureSkipVerify))
 	out.CA = (*config.ResourceReference)(unsafe.Pointer(in.CA))
@@ -495,3 +1748,123 @@ func autoConvert_config_TLSConfig_To_v1alpha1_TLSConfig(in *config.TLSConfig, ou
 func Convert_config_TLSConfig_To_v1alpha1_TLSConfig(in *config.TLSConfig, out *TLSConfig, s conversion.Scope) error {
 	return autoConvert_config_TLSConfig_To_v1alpha1_TLSConfig(in, out, s)
 }
+
+func autoConvert_v1alpha1_TailSamplingConfig_To_config_TailSamplingConfig(in *TailSamplingConfig, out *config.TailSamplingConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.DecisionWait = time.Duration(in.DecisionWait)
+	out.NumTraces = in.NumTraces
+	out.Policies = *(*[]config.TailSamplingPolicy)(unsafe.Pointer(&in.Policies))
+	return nil
+}
+
+// Convert_v1alpha1_TailSamplingConfig_To_config_TailSamplingConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TailSamplingConfig_To_config_TailSamplingConfig(in *TailSamplingConfig, out *config.TailSamplingConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TailSamplingConfig_To_config_TailSamplingConfig(in, out, s)
+}
+
+func autoConvert_config_TailSamplingConfig_To_v1alpha1_TailSamplingConfig(in *config.TailSamplingConfig, out *TailSamplingConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.DecisionWait = time.Duration(in.DecisionWait)
+	out.NumTraces = in.NumTraces
+	out.Policies = *(*[]TailSamplingPolicy)(unsafe.Pointer(&in.Policies))
+	return nil
+}
+
+// Convert_config_TailSamplingConfig_To_v1alpha1_TailSamplingConfig is an autogenerated conversion function.
+func Convert_config_TailSamplingConfig_To_v1alpha1_TailSamplingConfig(in *config.TailSamplingConfig, out *TailSamplingConfig, s conversion.Scope) error {
+	return autoConvert_config_TailSamplingConfig_To_v1alpha1_TailSamplingConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TailSamplingPolicy_To_config_TailSamplingPolicy(in *TailSamplingPolicy, out *config.TailSamplingPolicy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = config.TailSamplingPolicyType(in.Type)
+	out.Latency = (*config.LatencyPolicyConfig)(unsafe.Pointer(in.Latency))
+	out.StatusCode = (*config.StatusCodePolicyConfig)(unsafe.Pointer(in.StatusCode))
+	out.StringAttribute = (*config.StringAttributePolicyConfig)(unsafe.Pointer(in.StringAttribute))
+	return nil
+}
+
+// Convert_v1alpha1_TailSamplingPolicy_To_config_TailSamplingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_TailSamplingPolicy_To_config_TailSamplingPolicy(in *TailSamplingPolicy, out *config.TailSamplingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TailSamplingPolicy_To_config_TailSamplingPolicy(in, out, s)
+}
+
+func autoConvert_config_TailSamplingPolicy_To_v1alpha1_TailSamplingPolicy(in *config.TailSamplingPolicy, out *TailSamplingPolicy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = TailSamplingPolicyType(in.Type)
+	out.Latency = (*LatencyPolicyConfig)(unsafe.Pointer(in.Latency))
+	out.StatusCode = (*StatusCodePolicyConfig)(unsafe.Pointer(in.StatusCode))
+	out.StringAttribute = (*StringAttributePolicyConfig)(unsafe.Pointer(in.StringAttribute))
+	return nil
+}
+
+// Convert_config_TailSamplingPolicy_To_v1alpha1_TailSamplingPolicy is an autogenerated conversion function.
+func Convert_config_TailSamplingPolicy_To_v1alpha1_TailSamplingPolicy(in *config.TailSamplingPolicy, out *TailSamplingPolicy, s conversion.Scope) error {
+	return autoConvert_config_TailSamplingPolicy_To_v1alpha1_TailSamplingPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetAllocatorScalingConfig_To_config_TargetAllocatorScalingConfig(in *TargetAllocatorScalingConfig, out *config.TargetAllocatorScalingConfig, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_TargetAllocatorScalingConfig_To_config_TargetAllocatorScalingConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TargetAllocatorScalingConfig_To_config_TargetAllocatorScalingConfig(in *TargetAllocatorScalingConfig, out *config.TargetAllocatorScalingConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetAllocatorScalingConfig_To_config_TargetAllocatorScalingConfig(in, out, s)
+}
+
+func autoConvert_config_TargetAllocatorScalingConfig_To_v1alpha1_TargetAllocatorScalingConfig(in *config.TargetAllocatorScalingConfig, out *TargetAllocatorScalingConfig, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_config_TargetAllocatorScalingConfig_To_v1alpha1_TargetAllocatorScalingConfig is an autogenerated conversion function.
+func Convert_config_TargetAllocatorScalingConfig_To_v1alpha1_TargetAllocatorScalingConfig(in *config.TargetAllocatorScalingConfig, out *TargetAllocatorScalingConfig, s conversion.Scope) error {
+	return autoConvert_config_TargetAllocatorScalingConfig_To_v1alpha1_TargetAllocatorScalingConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TracesFilterConfig_To_config_TracesFilterConfig(in *TracesFilterConfig, out *config.TracesFilterConfig, s conversion.Scope) error {
+	out.Span = *(*[]string)(unsafe.Pointer(&in.Span))
+	out.SpanEvent = *(*[]string)(unsafe.Pointer(&in.SpanEvent))
+	return nil
+}
+
+// Convert_v1alpha1_TracesFilterConfig_To_config_TracesFilterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TracesFilterConfig_To_config_TracesFilterConfig(in *TracesFilterConfig, out *config.TracesFilterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TracesFilterConfig_To_config_TracesFilterConfig(in, out, s)
+}
+
+func autoConvert_config_TracesFilterConfig_To_v1alpha1_TracesFilterConfig(in *config.TracesFilterConfig, out *TracesFilterConfig, s conversion.Scope) error {
+	out.Span = *(*[]string)(unsafe.Pointer(&in.Span))
+	out.SpanEvent = *(*[]string)(unsafe.Pointer(&in.SpanEvent))
+	return nil
+}
+
+// Convert_config_TracesFilterConfig_To_v1alpha1_TracesFilterConfig is an autogenerated conversion function.
+func Convert_config_TracesFilterConfig_To_v1alpha1_TracesFilterConfig(in *config.TracesFilterConfig, out *TracesFilterConfig, s conversion.Scope) error {
+	return autoConvert_config_TracesFilterConfig_To_v1alpha1_TracesFilterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TracesPipelineConfig_To_config_TracesPipelineConfig(in *TracesPipelineConfig, out *config.TracesPipelineConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Exporters = *(*[]string)(unsafe.Pointer(&in.Exporters))
+	return nil
+}
+
+// Convert_v1alpha1_TracesPipelineConfig_To_config_TracesPipelineConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TracesPipelineConfig_To_config_TracesPipelineConfig(in *TracesPipelineConfig, out *config.TracesPipelineConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TracesPipelineConfig_To_config_TracesPipelineConfig(in, out, s)
+}
+
+func autoConvert_config_TracesPipelineConfig_To_v1alpha1_TracesPipelineConfig(in *config.TracesPipelineConfig, out *TracesPipelineConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Exporters = *(*[]string)(unsafe.Pointer(&in.Exporters))
+	return nil
+}
+
+// Convert_config_TracesPipelineConfig_To_v1alpha1_TracesPipelineConfig is an autogenerated conversion function.
+func Convert_config_TracesPipelineConfig_To_v1alpha1_TracesPipelineConfig(in *config.TracesPipelineConfig, out *TracesPipelineConfig, s conversion.Scope) error {
+	return autoConvert_config_TracesPipelineConfig_To_v1alpha1_TracesPipelineConfig(in, out, s)
+}
@@ -6,9 +6,59 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingConfig) DeepCopyInto(out *AutoscalingConfig) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilization != nil {
+		in, out := &in.TargetCPUUtilization, &out.TargetCPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilization != nil {
+		in, out := &in.TargetMemoryUtilization, &out.TargetMemoryUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingConfig.
+func (in *AutoscalingConfig) DeepCopy() *AutoscalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorConfig) DeepCopyInto(out *CollectorConfig) {
 	*out = *in
@@ -39,8 +89,22 @@ func (in *CollectorConfig) DeepCopyObject() runtime.Object {
 func (in *CollectorConfigSpec) DeepCopyInto(out *CollectorConfigSpec) {
 	*out = *in
 	in.Exporters.DeepCopyInto(&out.Exporters)
+	in.Pipelines.DeepCopyInto(&out.Pipelines)
+	if in.KubernetesObjects != nil {
+		in, out := &in.KubernetesObjects, &out.KubernetesObjects
+		*out = make([]KubernetesObjectConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Events.DeepCopyInto(&out.Events)
+	in.ResourceAttributes.DeepCopyInto(&out.ResourceAttributes)
+	in.Sampling.DeepCopyInto(&out.Sampling)
+	in.Filters.DeepCopyInto(&out.Filters)
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Scaling.DeepCopyInto(&out.Scaling)
 	out.Logs = in.Logs
-	out.Metrics = in.Metrics
+	in.Metrics.DeepCopyInto(&out.Metrics)
 	return
 }
 
@@ -60,6 +124,22 @@ func (in *CollectorExportersConfig) DeepCopyInto(out *CollectorExportersConfig)
 	in.OTLPGRPCExporter.DeepCopyInto(&out.OTLPGRPCExporter)
 	in.OTLPHTTPExporter.DeepCopyInto(&out.OTLPHTTPExporter)
 	in.DebugExporter.DeepCopyInto(&out.DebugExporter)
+	in.PrometheusRemoteWriteExporter.DeepCopyInto(&out.PrometheusRemoteWriteExporter)
+	in.KafkaExporter.DeepCopyInto(&out.KafkaExporter)
+	if in.NamedOTLPGRPCExporters != nil {
+		in, out := &in.NamedOTLPGRPCExporters, &out.NamedOTLPGRPCExporters
+		*out = make([]NamedOTLPGRPCExporterConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NamedOTLPHTTPExporters != nil {
+		in, out := &in.NamedOTLPHTTPExporters, &out.NamedOTLPHTTPExporters
+		*out = make([]NamedOTLPHTTPExporterConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -92,6 +172,7 @@ func (in *CollectorLogsConfig) DeepCopy() *CollectorLogsConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorMetricsConfig) DeepCopyInto(out *CollectorMetricsConfig) {
 	*out = *in
+	in.Scrape.DeepCopyInto(&out.Scrape)
 	return
 }
 
@@ -105,6 +186,125 @@ func (in *CollectorMetricsConfig) DeepCopy() *CollectorMetricsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorPipelinesConfig) DeepCopyInto(out *CollectorPipelinesConfig) {
+	*out = *in
+	in.Logs.DeepCopyInto(&out.Logs)
+	in.Events.DeepCopyInto(&out.Events)
+	in.Metrics.DeepCopyInto(&out.Metrics)
+	in.Traces.DeepCopyInto(&out.Traces)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorPipelinesConfig.
+func (in *CollectorPipelinesConfig) DeepCopy() *CollectorPipelinesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorPipelinesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorScalingConfig) DeepCopyInto(out *CollectorScalingConfig) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorScalingConfig.
+func (in *CollectorScalingConfig) DeepCopy() *CollectorScalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorScalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorStatus) DeepCopyInto(out *CollectorStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Pipelines != nil {
+		in, out := &in.Pipelines, &out.Pipelines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exporters != nil {
+		in, out := &in.Exporters, &out.Exporters
+		*out = make([]ExporterStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorStatus.
+func (in *CollectorStatus) DeepCopy() *CollectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CollectorStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorStorageConfig) DeepCopyInto(out *CollectorStorageConfig) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorStorageConfig.
+func (in *CollectorStorageConfig) DeepCopy() *CollectorStorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorStorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DebugExporterConfig) DeepCopyInto(out *DebugExporterConfig) {
 	*out = *in
@@ -127,155 +327,882 @@ func (in *DebugExporterConfig) DeepCopy() *DebugExporterConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OTLPGRPCExporterConfig) DeepCopyInto(out *OTLPGRPCExporterConfig) {
+func (in *EventsConfig) DeepCopyInto(out *EventsConfig) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeNamespaces != nil {
+		in, out := &in.ExcludeNamespaces, &out.ExcludeNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]EventType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeReasons != nil {
+		in, out := &in.ExcludeReasons, &out.ExcludeReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OnlyNewEvents != nil {
+		in, out := &in.OnlyNewEvents, &out.OnlyNewEvents
 		*out = new(bool)
 		**out = **in
 	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(TLSConfig)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventsConfig.
+func (in *EventsConfig) DeepCopy() *EventsConfig {
+	if in == nil {
+		return nil
 	}
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
-		*out = new(ResourceReference)
-		**out = **in
+	out := new(EventsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExporterStatus) DeepCopyInto(out *ExporterStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExporterStatus.
+func (in *ExporterStatus) DeepCopy() *ExporterStatus {
+	if in == nil {
+		return nil
 	}
-	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
+	out := new(ExporterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FiltersConfig) DeepCopyInto(out *FiltersConfig) {
+	*out = *in
+	in.Logs.DeepCopyInto(&out.Logs)
+	in.Metrics.DeepCopyInto(&out.Metrics)
+	in.Traces.DeepCopyInto(&out.Traces)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPGRPCExporterConfig.
-func (in *OTLPGRPCExporterConfig) DeepCopy() *OTLPGRPCExporterConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FiltersConfig.
+func (in *FiltersConfig) DeepCopy() *FiltersConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OTLPGRPCExporterConfig)
+	out := new(FiltersConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OTLPHTTPExporterConfig) DeepCopyInto(out *OTLPHTTPExporterConfig) {
+func (in *KafkaExporterConfig) DeepCopyInto(out *KafkaExporterConfig) {
 	*out = *in
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Topics = in.Topics
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(KafkaSASLConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
+	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaExporterConfig.
+func (in *KafkaExporterConfig) DeepCopy() *KafkaExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLConfig) DeepCopyInto(out *KafkaSASLConfig) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
 		*out = new(ResourceReference)
 		**out = **in
 	}
-	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPHTTPExporterConfig.
-func (in *OTLPHTTPExporterConfig) DeepCopy() *OTLPHTTPExporterConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLConfig.
+func (in *KafkaSASLConfig) DeepCopy() *KafkaSASLConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OTLPHTTPExporterConfig)
+	out := new(KafkaSASLConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+func (in *KafkaTopicsConfig) DeepCopyInto(out *KafkaTopicsConfig) {
 	*out = *in
-	out.ResourceRef = in.ResourceRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
-func (in *ResourceReference) DeepCopy() *ResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicsConfig.
+func (in *KafkaTopicsConfig) DeepCopy() *KafkaTopicsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReference)
+	out := new(KafkaTopicsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReferenceDetails) DeepCopyInto(out *ResourceReferenceDetails) {
+func (in *KubernetesObjectConfig) DeepCopyInto(out *KubernetesObjectConfig) {
 	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReferenceDetails.
-func (in *ResourceReferenceDetails) DeepCopy() *ResourceReferenceDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesObjectConfig.
+func (in *KubernetesObjectConfig) DeepCopy() *KubernetesObjectConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReferenceDetails)
+	out := new(KubernetesObjectConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetryOnFailureConfig) DeepCopyInto(out *RetryOnFailureConfig) {
+func (in *LatencyPolicyConfig) DeepCopyInto(out *LatencyPolicyConfig) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyPolicyConfig.
+func (in *LatencyPolicyConfig) DeepCopy() *LatencyPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogsFilterConfig) DeepCopyInto(out *LogsFilterConfig) {
+	*out = *in
+	if in.LogRecord != nil {
+		in, out := &in.LogRecord, &out.LogRecord
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryOnFailureConfig.
-func (in *RetryOnFailureConfig) DeepCopy() *RetryOnFailureConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogsFilterConfig.
+func (in *LogsFilterConfig) DeepCopy() *LogsFilterConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(RetryOnFailureConfig)
+	out := new(LogsFilterConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
+func (in *MetricsFilterConfig) DeepCopyInto(out *MetricsFilterConfig) {
 	*out = *in
-	if in.InsecureSkipVerify != nil {
-		in, out := &in.InsecureSkipVerify, &out.InsecureSkipVerify
-		*out = new(bool)
-		**out = **in
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.CA != nil {
-		in, out := &in.CA, &out.CA
-		*out = new(ResourceReference)
-		**out = **in
+	if in.DataPoint != nil {
+		in, out := &in.DataPoint, &out.DataPoint
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Cert != nil {
-		in, out := &in.Cert, &out.Cert
-		*out = new(ResourceReference)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsFilterConfig.
+func (in *MetricsFilterConfig) DeepCopy() *MetricsFilterConfig {
+	if in == nil {
+		return nil
 	}
-	if in.Key != nil {
-		in, out := &in.Key, &out.Key
-		*out = new(ResourceReference)
-		**out = **in
+	out := new(MetricsFilterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsScrapeConfig) DeepCopyInto(out *MetricsScrapeConfig) {
+	*out = *in
+	if in.ServiceMonitorSelector != nil {
+		in, out := &in.ServiceMonitorSelector, &out.ServiceMonitorSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodMonitorSelector != nil {
+		in, out := &in.PodMonitorSelector, &out.PodMonitorSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScrapeConfigSelector != nil {
+		in, out := &in.ScrapeConfigSelector, &out.ScrapeConfigSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricRelabelConfigs != nil {
+		in, out := &in.MetricRelabelConfigs, &out.MetricRelabelConfigs
+		*out = make([]RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
-func (in *TLSConfig) DeepCopy() *TLSConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsScrapeConfig.
+func (in *MetricsScrapeConfig) DeepCopy() *MetricsScrapeConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(TLSConfig)
+	out := new(MetricsScrapeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedOTLPGRPCExporterConfig) DeepCopyInto(out *NamedOTLPGRPCExporterConfig) {
+	*out = *in
+	in.OTLPGRPCExporterConfig.DeepCopyInto(&out.OTLPGRPCExporterConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedOTLPGRPCExporterConfig.
+func (in *NamedOTLPGRPCExporterConfig) DeepCopy() *NamedOTLPGRPCExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedOTLPGRPCExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedOTLPHTTPExporterConfig) DeepCopyInto(out *NamedOTLPHTTPExporterConfig) {
+	*out = *in
+	in.OTLPHTTPExporterConfig.DeepCopyInto(&out.OTLPHTTPExporterConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedOTLPHTTPExporterConfig.
+func (in *NamedOTLPHTTPExporterConfig) DeepCopy() *NamedOTLPHTTPExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedOTLPHTTPExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPGRPCExporterConfig) DeepCopyInto(out *OTLPGRPCExporterConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
+	in.SendingQueue.DeepCopyInto(&out.SendingQueue)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPGRPCExporterConfig.
+func (in *OTLPGRPCExporterConfig) DeepCopy() *OTLPGRPCExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPGRPCExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPHTTPExporterConfig) DeepCopyInto(out *OTLPHTTPExporterConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
+	in.SendingQueue.DeepCopyInto(&out.SendingQueue)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPHTTPExporterConfig.
+func (in *OTLPHTTPExporterConfig) DeepCopy() *OTLPHTTPExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPHTTPExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineConfig) DeepCopyInto(out *PipelineConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Exporters != nil {
+		in, out := &in.Exporters, &out.Exporters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineConfig.
+func (in *PipelineConfig) DeepCopy() *PipelineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbabilisticSamplerConfig) DeepCopyInto(out *ProbabilisticSamplerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbabilisticSamplerConfig.
+func (in *ProbabilisticSamplerConfig) DeepCopy() *ProbabilisticSamplerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbabilisticSamplerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWriteExporterConfig) DeepCopyInto(out *PrometheusRemoteWriteExporterConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.ExternalLabels != nil {
+		in, out := &in.ExternalLabels, &out.ExternalLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.WAL.DeepCopyInto(&out.WAL)
+	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWriteExporterConfig.
+func (in *PrometheusRemoteWriteExporterConfig) DeepCopy() *PrometheusRemoteWriteExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWriteExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWriteWALConfig) DeepCopyInto(out *PrometheusRemoteWriteWALConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWriteWALConfig.
+func (in *PrometheusRemoteWriteWALConfig) DeepCopy() *PrometheusRemoteWriteWALConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWriteWALConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelabelConfig) DeepCopyInto(out *RelabelConfig) {
+	*out = *in
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelabelConfig.
+func (in *RelabelConfig) DeepCopy() *RelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAttributesConfig) DeepCopyInto(out *ResourceAttributesConfig) {
+	*out = *in
+	if in.Static != nil {
+		in, out := &in.Static, &out.Static
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ShootLabels != nil {
+		in, out := &in.ShootLabels, &out.ShootLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ShootAnnotations != nil {
+		in, out := &in.ShootAnnotations, &out.ShootAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAttributesConfig.
+func (in *ResourceAttributesConfig) DeepCopy() *ResourceAttributesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAttributesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+	*out = *in
+	out.ResourceRef = in.ResourceRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
+func (in *ResourceReference) DeepCopy() *ResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReferenceDetails) DeepCopyInto(out *ResourceReferenceDetails) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReferenceDetails.
+func (in *ResourceReferenceDetails) DeepCopy() *ResourceReferenceDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReferenceDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryOnFailureConfig) DeepCopyInto(out *RetryOnFailureConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryOnFailureConfig.
+func (in *RetryOnFailureConfig) DeepCopy() *RetryOnFailureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryOnFailureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SamplingConfig) DeepCopyInto(out *SamplingConfig) {
+	*out = *in
+	in.Probabilistic.DeepCopyInto(&out.Probabilistic)
+	in.TailSampling.DeepCopyInto(&out.TailSampling)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SamplingConfig.
+func (in *SamplingConfig) DeepCopy() *SamplingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SamplingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingConfig) DeepCopyInto(out *ScalingConfig) {
+	*out = *in
+	in.Collector.DeepCopyInto(&out.Collector)
+	in.TargetAllocator.DeepCopyInto(&out.TargetAllocator)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingConfig.
+func (in *ScalingConfig) DeepCopy() *ScalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendingQueueConfig) DeepCopyInto(out *SendingQueueConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendingQueueConfig.
+func (in *SendingQueueConfig) DeepCopy() *SendingQueueConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SendingQueueConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusCodePolicyConfig) DeepCopyInto(out *StatusCodePolicyConfig) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusCodePolicyConfig.
+func (in *StatusCodePolicyConfig) DeepCopy() *StatusCodePolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCodePolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StringAttributePolicyConfig) DeepCopyInto(out *StringAttributePolicyConfig) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StringAttributePolicyConfig.
+func (in *StringAttributePolicyConfig) DeepCopy() *StringAttributePolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StringAttributePolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
+	*out = *in
+	if in.InsecureSkipVerify != nil {
+		in, out := &in.InsecureSkipVerify, &out.InsecureSkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Cert != nil {
+		in, out := &in.Cert, &out.Cert
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
+func (in *TLSConfig) DeepCopy() *TLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailSamplingConfig) DeepCopyInto(out *TailSamplingConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]TailSamplingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailSamplingConfig.
+func (in *TailSamplingConfig) DeepCopy() *TailSamplingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TailSamplingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailSamplingPolicy) DeepCopyInto(out *TailSamplingPolicy) {
+	*out = *in
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(LatencyPolicyConfig)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(StatusCodePolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StringAttribute != nil {
+		in, out := &in.StringAttribute, &out.StringAttribute
+		*out = new(StringAttributePolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailSamplingPolicy.
+func (in *TailSamplingPolicy) DeepCopy() *TailSamplingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TailSamplingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetAllocatorScalingConfig) DeepCopyInto(out *TargetAllocatorScalingConfig) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetAllocatorScalingConfig.
+func (in *TargetAllocatorScalingConfig) DeepCopy() *TargetAllocatorScalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetAllocatorScalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracesFilterConfig) DeepCopyInto(out *TracesFilterConfig) {
+	*out = *in
+	if in.Span != nil {
+		in, out := &in.Span, &out.Span
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpanEvent != nil {
+		in, out := &in.SpanEvent, &out.SpanEvent
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracesFilterConfig.
+func (in *TracesFilterConfig) DeepCopy() *TracesFilterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TracesFilterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracesPipelineConfig) DeepCopyInto(out *TracesPipelineConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Exporters != nil {
+		in, out := &in.Exporters, &out.Exporters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracesPipelineConfig.
+func (in *TracesPipelineConfig) DeepCopy() *TracesPipelineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TracesPipelineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	json "encoding/json"
 	time "time"
 
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -58,6 +59,19 @@ func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
 	if in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.Multiplier == 0 {
 		in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
 	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.NumConsumers == 0 {
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.NumConsumers = int(DefaultSendingQueueNumConsumers)
+	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.QueueSize == 0 {
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.QueueSize = int(DefaultSendingQueueSize)
+	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Storage == "" {
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Storage = SendingQueueStorage(SendingQueueStorageMemory)
+	}
 	if in.Spec.Exporters.OTLPGRPCExporter.Compression == "" {
 		in.Spec.Exporters.OTLPGRPCExporter.Compression = Compression(CompressionGzip)
 	}
@@ -102,6 +116,19 @@ func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
 	if in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.Multiplier == 0 {
 		in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
 	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.NumConsumers == 0 {
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.NumConsumers = int(DefaultSendingQueueNumConsumers)
+	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.QueueSize == 0 {
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.QueueSize = int(DefaultSendingQueueSize)
+	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Storage == "" {
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Storage = SendingQueueStorage(SendingQueueStorageMemory)
+	}
 	if in.Spec.Exporters.OTLPHTTPExporter.Compression == "" {
 		in.Spec.Exporters.OTLPHTTPExporter.Compression = Compression(CompressionGzip)
 	}
@@ -112,6 +139,284 @@ func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
 	if in.Spec.Exporters.DebugExporter.Verbosity == "" {
 		in.Spec.Exporters.DebugExporter.Verbosity = DebugExporterVerbosity(DebugExporterVerbosityBasic)
 	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.TLS != nil {
+		if in.Spec.Exporters.PrometheusRemoteWriteExporter.TLS.InsecureSkipVerify == nil {
+			var ptrVar1 bool = false
+			in.Spec.Exporters.PrometheusRemoteWriteExporter.TLS.InsecureSkipVerify = &ptrVar1
+		}
+		if in.Spec.Exporters.PrometheusRemoteWriteExporter.TLS.ReloadInterval == 0 {
+			in.Spec.Exporters.PrometheusRemoteWriteExporter.TLS.ReloadInterval = time.Duration(DefaultTLSReloadInterval)
+		}
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.Timeout == 0 {
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.Timeout = time.Duration(DefaultPrometheusRemoteWriteExporterTimeout)
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.WAL.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.WAL.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.WAL.BufferSize == 0 {
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.WAL.BufferSize = int(DefaultPrometheusRemoteWriteWALBufferSize)
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.WAL.TruncateFrequency == 0 {
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.WAL.TruncateFrequency = time.Duration(DefaultPrometheusRemoteWriteWALTruncateFrequency)
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.InitialInterval == 0 {
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.InitialInterval = time.Duration(DefaultRetryInitialInterval)
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.MaxInterval == 0 {
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.MaxInterval = time.Duration(DefaultRetryMaxInterval)
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.MaxElapsedTime == 0 {
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.MaxElapsedTime = time.Duration(DefaultRetryMaxElapsedTime)
+	}
+	if in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.Multiplier == 0 {
+		in.Spec.Exporters.PrometheusRemoteWriteExporter.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
+	}
+	if in.Spec.Exporters.KafkaExporter.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.KafkaExporter.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.KafkaExporter.Topics.Logs == "" {
+		in.Spec.Exporters.KafkaExporter.Topics.Logs = string(DefaultKafkaLogsTopic)
+	}
+	if in.Spec.Exporters.KafkaExporter.Topics.Metrics == "" {
+		in.Spec.Exporters.KafkaExporter.Topics.Metrics = string(DefaultKafkaMetricsTopic)
+	}
+	if in.Spec.Exporters.KafkaExporter.Topics.Traces == "" {
+		in.Spec.Exporters.KafkaExporter.Topics.Traces = string(DefaultKafkaTracesTopic)
+	}
+	if in.Spec.Exporters.KafkaExporter.Encoding == "" {
+		in.Spec.Exporters.KafkaExporter.Encoding = KafkaEncoding(KafkaEncodingOTLPProto)
+	}
+	if in.Spec.Exporters.KafkaExporter.SASL != nil {
+		if in.Spec.Exporters.KafkaExporter.SASL.Mechanism == "" {
+			in.Spec.Exporters.KafkaExporter.SASL.Mechanism = KafkaSASLMechanism(KafkaSASLMechanismSCRAMSHA512)
+		}
+	}
+	if in.Spec.Exporters.KafkaExporter.TLS != nil {
+		if in.Spec.Exporters.KafkaExporter.TLS.InsecureSkipVerify == nil {
+			var ptrVar1 bool = false
+			in.Spec.Exporters.KafkaExporter.TLS.InsecureSkipVerify = &ptrVar1
+		}
+		if in.Spec.Exporters.KafkaExporter.TLS.ReloadInterval == 0 {
+			in.Spec.Exporters.KafkaExporter.TLS.ReloadInterval = time.Duration(DefaultTLSReloadInterval)
+		}
+	}
+	if in.Spec.Exporters.KafkaExporter.Timeout == 0 {
+		in.Spec.Exporters.KafkaExporter.Timeout = time.Duration(DefaultKafkaExporterTimeout)
+	}
+	if in.Spec.Exporters.KafkaExporter.RetryOnFailure.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Exporters.KafkaExporter.RetryOnFailure.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.KafkaExporter.RetryOnFailure.InitialInterval == 0 {
+		in.Spec.Exporters.KafkaExporter.RetryOnFailure.InitialInterval = time.Duration(DefaultRetryInitialInterval)
+	}
+	if in.Spec.Exporters.KafkaExporter.RetryOnFailure.MaxInterval == 0 {
+		in.Spec.Exporters.KafkaExporter.RetryOnFailure.MaxInterval = time.Duration(DefaultRetryMaxInterval)
+	}
+	if in.Spec.Exporters.KafkaExporter.RetryOnFailure.MaxElapsedTime == 0 {
+		in.Spec.Exporters.KafkaExporter.RetryOnFailure.MaxElapsedTime = time.Duration(DefaultRetryMaxElapsedTime)
+	}
+	if in.Spec.Exporters.KafkaExporter.RetryOnFailure.Multiplier == 0 {
+		in.Spec.Exporters.KafkaExporter.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
+	}
+	for i := range in.Spec.Exporters.NamedOTLPGRPCExporters {
+		a := &in.Spec.Exporters.NamedOTLPGRPCExporters[i]
+		SetDefaults_NamedOTLPGRPCExporterConfig(a)
+		if a.OTLPGRPCExporterConfig.Enabled == nil {
+			var ptrVar1 bool = false
+			a.OTLPGRPCExporterConfig.Enabled = &ptrVar1
+		}
+		if a.OTLPGRPCExporterConfig.TLS != nil {
+			if a.OTLPGRPCExporterConfig.TLS.InsecureSkipVerify == nil {
+				var ptrVar1 bool = false
+				a.OTLPGRPCExporterConfig.TLS.InsecureSkipVerify = &ptrVar1
+			}
+			if a.OTLPGRPCExporterConfig.TLS.ReloadInterval == 0 {
+				a.OTLPGRPCExporterConfig.TLS.ReloadInterval = time.Duration(DefaultTLSReloadInterval)
+			}
+		}
+		if a.OTLPGRPCExporterConfig.Timeout == 0 {
+			a.OTLPGRPCExporterConfig.Timeout = time.Duration(DefaultGRPCExporterClientTimeout)
+		}
+		if a.OTLPGRPCExporterConfig.ReadBufferSize == 0 {
+			a.OTLPGRPCExporterConfig.ReadBufferSize = int(DefaultGRPCExporterClientReadBufferSize)
+		}
+		if a.OTLPGRPCExporterConfig.WriteBufferSize == 0 {
+			a.OTLPGRPCExporterConfig.WriteBufferSize = int(DefaultGRPCExporterClientWriteBufferSize)
+		}
+		if a.OTLPGRPCExporterConfig.RetryOnFailure.Enabled == nil {
+			var ptrVar1 bool = true
+			a.OTLPGRPCExporterConfig.RetryOnFailure.Enabled = &ptrVar1
+		}
+		if a.OTLPGRPCExporterConfig.RetryOnFailure.InitialInterval == 0 {
+			a.OTLPGRPCExporterConfig.RetryOnFailure.InitialInterval = time.Duration(DefaultRetryInitialInterval)
+		}
+		if a.OTLPGRPCExporterConfig.RetryOnFailure.MaxInterval == 0 {
+			a.OTLPGRPCExporterConfig.RetryOnFailure.MaxInterval = time.Duration(DefaultRetryMaxInterval)
+		}
+		if a.OTLPGRPCExporterConfig.RetryOnFailure.MaxElapsedTime == 0 {
+			a.OTLPGRPCExporterConfig.RetryOnFailure.MaxElapsedTime = time.Duration(DefaultRetryMaxElapsedTime)
+		}
+		if a.OTLPGRPCExporterConfig.RetryOnFailure.Multiplier == 0 {
+			a.OTLPGRPCExporterConfig.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
+		}
+		if a.OTLPGRPCExporterConfig.SendingQueue.Enabled == nil {
+			var ptrVar1 bool = true
+			a.OTLPGRPCExporterConfig.SendingQueue.Enabled = &ptrVar1
+		}
+		if a.OTLPGRPCExporterConfig.SendingQueue.NumConsumers == 0 {
+			a.OTLPGRPCExporterConfig.SendingQueue.NumConsumers = int(DefaultSendingQueueNumConsumers)
+		}
+		if a.OTLPGRPCExporterConfig.SendingQueue.QueueSize == 0 {
+			a.OTLPGRPCExporterConfig.SendingQueue.QueueSize = int(DefaultSendingQueueSize)
+		}
+		if a.OTLPGRPCExporterConfig.SendingQueue.Storage == "" {
+			a.OTLPGRPCExporterConfig.SendingQueue.Storage = SendingQueueStorage(SendingQueueStorageMemory)
+		}
+		if a.OTLPGRPCExporterConfig.Compression == "" {
+			a.OTLPGRPCExporterConfig.Compression = Compression(CompressionGzip)
+		}
+	}
+	for i := range in.Spec.Exporters.NamedOTLPHTTPExporters {
+		a := &in.Spec.Exporters.NamedOTLPHTTPExporters[i]
+		SetDefaults_NamedOTLPHTTPExporterConfig(a)
+		if a.OTLPHTTPExporterConfig.Enabled == nil {
+			var ptrVar1 bool = false
+			a.OTLPHTTPExporterConfig.Enabled = &ptrVar1
+		}
+		if a.OTLPHTTPExporterConfig.TLS != nil {
+			if a.OTLPHTTPExporterConfig.TLS.InsecureSkipVerify == nil {
+				var ptrVar1 bool = false
+				a.OTLPHTTPExporterConfig.TLS.InsecureSkipVerify = &ptrVar1
+			}
+			if a.OTLPHTTPExporterConfig.TLS.ReloadInterval == 0 {
+				a.OTLPHTTPExporterConfig.TLS.ReloadInterval = time.Duration(DefaultTLSReloadInterval)
+			}
+		}
+		if a.OTLPHTTPExporterConfig.Timeout == 0 {
+			a.OTLPHTTPExporterConfig.Timeout = time.Duration(DefaultHTTPExporterClientTimeout)
+		}
+		if a.OTLPHTTPExporterConfig.ReadBufferSize == 0 {
+			a.OTLPHTTPExporterConfig.ReadBufferSize = int(DefaultHTTPExporterClientReadBufferSize)
+		}
+		if a.OTLPHTTPExporterConfig.WriteBufferSize == 0 {
+			a.OTLPHTTPExporterConfig.WriteBufferSize = int(DefaultHTTPExporterClientWriteBufferSize)
+		}
+		if a.OTLPHTTPExporterConfig.Encoding == "" {
+			a.OTLPHTTPExporterConfig.Encoding = MessageEncoding(MessageEncodingProto)
+		}
+		if a.OTLPHTTPExporterConfig.RetryOnFailure.Enabled == nil {
+			var ptrVar1 bool = true
+			a.OTLPHTTPExporterConfig.RetryOnFailure.Enabled = &ptrVar1
+		}
+		if a.OTLPHTTPExporterConfig.RetryOnFailure.InitialInterval == 0 {
+			a.OTLPHTTPExporterConfig.RetryOnFailure.InitialInterval = time.Duration(DefaultRetryInitialInterval)
+		}
+		if a.OTLPHTTPExporterConfig.RetryOnFailure.MaxInterval == 0 {
+			a.OTLPHTTPExporterConfig.RetryOnFailure.MaxInterval = time.Duration(DefaultRetryMaxInterval)
+		}
+		if a.OTLPHTTPExporterConfig.RetryOnFailure.MaxElapsedTime == 0 {
+			a.OTLPHTTPExporterConfig.RetryOnFailure.MaxElapsedTime = time.Duration(DefaultRetryMaxElapsedTime)
+		}
+		if a.OTLPHTTPExporterConfig.RetryOnFailure.Multiplier == 0 {
+			a.OTLPHTTPExporterConfig.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
+		}
+		if a.OTLPHTTPExporterConfig.SendingQueue.Enabled == nil {
+			var ptrVar1 bool = true
+			a.OTLPHTTPExporterConfig.SendingQueue.Enabled = &ptrVar1
+		}
+		if a.OTLPHTTPExporterConfig.SendingQueue.NumConsumers == 0 {
+			a.OTLPHTTPExporterConfig.SendingQueue.NumConsumers = int(DefaultSendingQueueNumConsumers)
+		}
+		if a.OTLPHTTPExporterConfig.SendingQueue.QueueSize == 0 {
+			a.OTLPHTTPExporterConfig.SendingQueue.QueueSize = int(DefaultSendingQueueSize)
+		}
+		if a.OTLPHTTPExporterConfig.SendingQueue.Storage == "" {
+			a.OTLPHTTPExporterConfig.SendingQueue.Storage = SendingQueueStorage(SendingQueueStorageMemory)
+		}
+		if a.OTLPHTTPExporterConfig.Compression == "" {
+			a.OTLPHTTPExporterConfig.Compression = Compression(CompressionGzip)
+		}
+	}
+	if in.Spec.Pipelines.Logs.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Pipelines.Logs.Enabled = &ptrVar1
+	}
+	if in.Spec.Pipelines.Events.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Pipelines.Events.Enabled = &ptrVar1
+	}
+	if in.Spec.Pipelines.Metrics.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Pipelines.Metrics.Enabled = &ptrVar1
+	}
+	if in.Spec.Pipelines.Traces.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Pipelines.Traces.Enabled = &ptrVar1
+	}
+	for i := range in.Spec.KubernetesObjects {
+		a := &in.Spec.KubernetesObjects[i]
+		if a.Mode == "" {
+			a.Mode = KubernetesObjectMode(KubernetesObjectModePull)
+		}
+		if a.Interval == 0 {
+			a.Interval = time.Duration(DefaultKubernetesObjectInterval)
+		}
+	}
+	if in.Spec.Events.OnlyNewEvents == nil {
+		var ptrVar1 bool = false
+		in.Spec.Events.OnlyNewEvents = &ptrVar1
+	}
+	if in.Spec.Sampling.Probabilistic.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Sampling.Probabilistic.Enabled = &ptrVar1
+	}
+	if in.Spec.Sampling.Probabilistic.SamplingPercentage == 0 {
+		in.Spec.Sampling.Probabilistic.SamplingPercentage = float64(DefaultSamplingPercentage)
+	}
+	if in.Spec.Sampling.TailSampling.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Sampling.TailSampling.Enabled = &ptrVar1
+	}
+	if in.Spec.Sampling.TailSampling.DecisionWait == 0 {
+		in.Spec.Sampling.TailSampling.DecisionWait = time.Duration(DefaultTailSamplingDecisionWait)
+	}
+	if in.Spec.Sampling.TailSampling.NumTraces == 0 {
+		in.Spec.Sampling.TailSampling.NumTraces = int(DefaultTailSamplingNumTraces)
+	}
+	if in.Spec.Filters.ErrorMode == "" {
+		in.Spec.Filters.ErrorMode = FilterErrorMode(FilterErrorModeIgnore)
+	}
+	if in.Spec.Storage.Size == nil {
+		if err := json.Unmarshal([]byte(`"1Gi"`), &in.Spec.Storage.Size); err != nil {
+			panic(err)
+		}
+	}
+	if in.Spec.Scaling.Collector.Replicas == nil {
+		var ptrVar1 int32 = 1
+		in.Spec.Scaling.Collector.Replicas = &ptrVar1
+	}
+	if in.Spec.Scaling.Collector.Autoscaling != nil {
+		if in.Spec.Scaling.Collector.Autoscaling.MinReplicas == nil {
+			var ptrVar1 int32 = 1
+			in.Spec.Scaling.Collector.Autoscaling.MinReplicas = &ptrVar1
+		}
+	}
+	if in.Spec.Scaling.TargetAllocator.Replicas == nil {
+		var ptrVar1 int32 = 1
+		in.Spec.Scaling.TargetAllocator.Replicas = &ptrVar1
+	}
 	if in.Spec.Logs.Level == "" {
 		in.Spec.Logs.Level = LogLevel(LogLevelInfo)
 	}
@@ -121,4 +426,16 @@ func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
 	if in.Spec.Metrics.Level == "" {
 		in.Spec.Metrics.Level = MetricsVerbosityLevel(MetricsVerbosityLevelNormal)
 	}
+	if in.Spec.Metrics.Scrape.Interval == 0 {
+		in.Spec.Metrics.Scrape.Interval = time.Duration(DefaultScrapeInterval)
+	}
+	if in.Spec.Metrics.Scrape.AllocationStrategy == "" {
+		in.Spec.Metrics.Scrape.AllocationStrategy = AllocationStrategy(AllocationStrategyConsistentHashing)
+	}
+	for i := range in.Spec.Metrics.Scrape.MetricRelabelConfigs {
+		a := &in.Spec.Metrics.Scrape.MetricRelabelConfigs[i]
+		if a.Action == "" {
+			a.Action = RelabelAction(RelabelActionReplace)
+		}
+	}
 }
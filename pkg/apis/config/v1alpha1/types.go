@@ -7,6 +7,8 @@ package v1alpha1
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -103,6 +105,14 @@ const (
 )
 
 const (
+	// DefaultScrapeInterval specifies the default interval, in which the
+	// targets of monitors without an explicit interval are scraped.
+	DefaultScrapeInterval = 30 * time.Second
+
+	// DefaultKubernetesObjectInterval specifies the default interval, in
+	// which the Kubernetes objects in pull mode are pulled.
+	DefaultKubernetesObjectInterval = time.Hour
+
 	// DefaultRetryInitialInterval specifies the default initial interval to
 	// wait after the first failure, before attempting a retry.
 	DefaultRetryInitialInterval = 5 * time.Second
@@ -135,6 +145,44 @@ const (
 	// WriteBufferSize for the gRPC client used by the exporters.
 	DefaultGRPCExporterClientWriteBufferSize = 32 * 1024
 
+	// DefaultPrometheusRemoteWriteExporterTimeout specifies the default
+	// timeout of the requests made by the Prometheus Remote Write exporter.
+	DefaultPrometheusRemoteWriteExporterTimeout = 5 * time.Second
+	// DefaultPrometheusRemoteWriteWALBufferSize specifies the default number
+	// of objects to retrieve from the WAL at once.
+	DefaultPrometheusRemoteWriteWALBufferSize = 300
+	// DefaultPrometheusRemoteWriteWALTruncateFrequency specifies the default
+	// frequency at which the WAL is truncated.
+	DefaultPrometheusRemoteWriteWALTruncateFrequency = time.Minute
+
+	// DefaultSendingQueueNumConsumers specifies the default number of
+	// consumers, which dequeue batches from the sending queue.
+	DefaultSendingQueueNumConsumers = 10
+	// DefaultSendingQueueSize specifies the default max number of batches
+	// in the sending queue.
+	DefaultSendingQueueSize = 1000
+
+	// DefaultSamplingPercentage specifies the default percentage of traces
+	// sampled by the probabilistic sampler.
+	DefaultSamplingPercentage = 100.0
+	// DefaultTailSamplingDecisionWait specifies the default time to wait
+	// before making a tail sampling decision for a trace.
+	DefaultTailSamplingDecisionWait = 30 * time.Second
+	// DefaultTailSamplingNumTraces specifies the default number of traces
+	// kept in memory by the tail sampling.
+	DefaultTailSamplingNumTraces = 50000
+
+	// DefaultKafkaExporterTimeout specifies the default timeout for every
+	// attempt to send data to the Kafka brokers.
+	DefaultKafkaExporterTimeout = 5 * time.Second
+	// DefaultKafkaLogsTopic specifies the default Kafka topic for logs.
+	DefaultKafkaLogsTopic = "otlp_logs"
+	// DefaultKafkaMetricsTopic specifies the default Kafka topic for
+	// metrics.
+	DefaultKafkaMetricsTopic = "otlp_metrics"
+	// DefaultKafkaTracesTopic specifies the default Kafka topic for traces.
+	DefaultKafkaTracesTopic = "otlp_spans"
+
 	// DefaultTLSReloadInterval specifies the default interval at which the
 	// OTel Collector re-reads TLS material (CA, client cert, client key)
 	// from disk. Without it, the collector loads the certs once at startup
@@ -184,6 +232,148 @@ type RetryOnFailureConfig struct {
 	Multiplier float64 `json:"multiplier,omitzero"`
 }
 
+// AllocationStrategy specifies how the Target Allocator distributes the
+// scrape targets among the collector replicas.
+//
+// See the link below for more details.
+//
+// https://github.com/open-telemetry/opentelemetry-operator/tree/main/cmd/otel-allocator#allocation-strategies
+//
+// +k8s:enum
+type AllocationStrategy string
+
+const (
+	// AllocationStrategyConsistentHashing specifies that the scrape targets
+	// are distributed by consistent hashing, which keeps most of the targets
+	// on the same collector, when the number of collectors changes.
+	AllocationStrategyConsistentHashing AllocationStrategy = "consistent-hashing"
+	// AllocationStrategyLeastWeighted specifies that each scrape target is
+	// assigned to the collector with the least number of targets.
+	AllocationStrategyLeastWeighted AllocationStrategy = "least-weighted"
+)
+
+// RelabelAction specifies the action of a [RelabelConfig].
+//
+// See the link below for more details.
+//
+// https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+//
+// +k8s:enum
+type RelabelAction string
+
+const (
+	// RelabelActionReplace specifies that the target label is set to the
+	// replacement, if the regex matches the concatenated source labels.
+	RelabelActionReplace RelabelAction = "replace"
+	// RelabelActionKeep specifies that the series, for which the regex does
+	// not match the concatenated source labels, are dropped.
+	RelabelActionKeep RelabelAction = "keep"
+	// RelabelActionDrop specifies that the series, for which the regex
+	// matches the concatenated source labels, are dropped.
+	RelabelActionDrop RelabelAction = "drop"
+	// RelabelActionKeepEqual specifies that the series, for which the
+	// concatenated source labels do not match the target label, are
+	// dropped.
+	RelabelActionKeepEqual RelabelAction = "keepequal"
+	// RelabelActionDropEqual specifies that the series, for which the
+	// concatenated source labels match the target label, are dropped.
+	RelabelActionDropEqual RelabelAction = "dropequal"
+	// RelabelActionHashMod specifies that the target label is set to the
+	// modulus of a hash of the concatenated source labels.
+	RelabelActionHashMod RelabelAction = "hashmod"
+	// RelabelActionLabelMap specifies that the values of all labels, whose
+	// names match the regex, are copied to the labels named by the
+	// replacement.
+	RelabelActionLabelMap RelabelAction = "labelmap"
+	// RelabelActionLabelDrop specifies that all labels, whose names match
+	// the regex, are removed.
+	RelabelActionLabelDrop RelabelAction = "labeldrop"
+	// RelabelActionLabelKeep specifies that all labels, whose names do not
+	// match the regex, are removed.
+	RelabelActionLabelKeep RelabelAction = "labelkeep"
+	// RelabelActionLowercase specifies that the target label is set to the
+	// lowercased concatenated source labels.
+	RelabelActionLowercase RelabelAction = "lowercase"
+	// RelabelActionUppercase specifies that the target label is set to the
+	// uppercased concatenated source labels.
+	RelabelActionUppercase RelabelAction = "uppercase"
+)
+
+// KubernetesObjectMode specifies how the Kubernetes objects of the shoot
+// cluster are collected.
+//
+// +k8s:enum
+type KubernetesObjectMode string
+
+const (
+	// KubernetesObjectModePull specifies that the objects are listed
+	// periodically.
+	KubernetesObjectModePull KubernetesObjectMode = "pull"
+	// KubernetesObjectModeWatch specifies that the changes of the objects are
+	// watched.
+	KubernetesObjectModeWatch KubernetesObjectMode = "watch"
+)
+
+// EventType specifies the type of a Kubernetes event.
+//
+// +k8s:enum
+type EventType string
+
+const (
+	// EventTypeNormal specifies events, which report a normal operation.
+	EventTypeNormal EventType = "Normal"
+	// EventTypeWarning specifies events, which report a potential problem.
+	EventTypeWarning EventType = "Warning"
+)
+
+// SendingQueueStorage specifies where the sending queue of an exporter is
+// stored.
+//
+// +k8s:enum
+type SendingQueueStorage string
+
+const (
+	// SendingQueueStorageMemory specifies that the sending queue is kept in
+	// memory and is lost when the collector restarts.
+	SendingQueueStorageMemory SendingQueueStorage = "memory"
+	// SendingQueueStorageFile specifies that the sending queue is persisted
+	// in the file storage of the collector, which is backed by a persistent
+	// volume.
+	SendingQueueStorageFile SendingQueueStorage = "file"
+)
+
+// SendingQueueConfig provides the sending queue settings of an exporter.
+type SendingQueueConfig struct {
+	// Enabled specifies whether the sending queue is enabled or not.
+	//
+	// +k8s:optional
+	// +default=true
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// NumConsumers specifies the number of consumers, which dequeue
+	// batches from the queue. The default value is
+	// [DefaultSendingQueueNumConsumers].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultSendingQueueNumConsumers)
+	NumConsumers int `json:"num_consumers,omitzero"`
+
+	// QueueSize specifies the max number of batches in the queue. The
+	// default value is [DefaultSendingQueueSize].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultSendingQueueSize)
+	QueueSize int `json:"queue_size,omitzero"`
+
+	// Storage specifies where the queue is stored. A queue stored in the
+	// file storage survives restarts of the collector. The default value
+	// is [SendingQueueStorageMemory].
+	//
+	// +k8s:optional
+	// +default=ref(SendingQueueStorageMemory)
+	Storage SendingQueueStorage `json:"storage,omitzero"`
+}
+
 // OTLPHTTPExporterConfig provides the OTLP HTTP Exporter configuration settings.
 //
 // See [OTLP HTTP Exporter] for more details.
@@ -280,6 +470,11 @@ type OTLPHTTPExporterConfig struct {
 	// +k8s:optional
 	RetryOnFailure RetryOnFailureConfig `json:"retry_on_failure,omitzero"`
 
+	// SendingQueue specifies the sending queue settings of the exporter.
+	//
+	// +k8s:optional
+	SendingQueue SendingQueueConfig `json:"sending_queue,omitzero"`
+
 	// Compression specifies the compression to use. The default value is
 	// [CompressionGzip].
 	//
@@ -372,6 +567,11 @@ type OTLPGRPCExporterConfig struct {
 	// +k8s:optional
 	RetryOnFailure RetryOnFailureConfig `json:"retry_on_failure,omitzero"`
 
+	// SendingQueue specifies the sending queue settings of the exporter.
+	//
+	// +k8s:optional
+	SendingQueue SendingQueueConfig `json:"sending_queue,omitzero"`
+
 	// Compression specifies the compression to use. The default value is
 	// [CompressionGzip].
 	//
@@ -380,6 +580,250 @@ type OTLPGRPCExporterConfig struct {
 	Compression Compression `json:"compression,omitzero"`
 }
 
+// PrometheusRemoteWriteWALConfig provides the Write-Ahead Log settings of the
+// Prometheus Remote Write exporter.
+type PrometheusRemoteWriteWALConfig struct {
+	// Enabled specifies whether the Write-Ahead Log is enabled or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// BufferSize specifies the number of objects to retrieve from the WAL
+	// at once. The default value is
+	// [DefaultPrometheusRemoteWriteWALBufferSize].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultPrometheusRemoteWriteWALBufferSize)
+	BufferSize int `json:"buffer_size,omitzero"`
+
+	// TruncateFrequency specifies how often the WAL is truncated. The
+	// default value is [DefaultPrometheusRemoteWriteWALTruncateFrequency].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultPrometheusRemoteWriteWALTruncateFrequency)
+	TruncateFrequency time.Duration `json:"truncate_frequency,omitzero"`
+}
+
+// PrometheusRemoteWriteExporterConfig provides the Prometheus Remote Write
+// Exporter config settings. The exporter supports the metrics signal only.
+//
+// See [Prometheus Remote Write Exporter] for more details.
+//
+// [Prometheus Remote Write Exporter]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/prometheusremotewriteexporter
+type PrometheusRemoteWriteExporterConfig struct {
+	// Enabled specifies whether the Prometheus Remote Write exporter is
+	// enabled or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Endpoint specifies the remote write URL to send the metrics to, e.g.
+	// https://example.com/api/v1/receive
+	//
+	// +k8s:required
+	Endpoint string `json:"endpoint,omitzero"`
+
+	// TLS specifies the TLS configuration settings for the exporter.
+	//
+	// +k8s:optional
+	TLS *TLSConfig `json:"tls,omitzero"`
+
+	// Token references a bearer token for authentication.
+	//
+	// +k8s:optional
+	Token *ResourceReference `json:"token,omitzero"`
+
+	// Timeout specifies the HTTP request time limit. The default value is
+	// [DefaultPrometheusRemoteWriteExporterTimeout].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultPrometheusRemoteWriteExporterTimeout)
+	Timeout time.Duration `json:"timeout,omitzero"`
+
+	// ExternalLabels specifies the labels, which are added to each exported
+	// time series.
+	//
+	// +k8s:optional
+	ExternalLabels map[string]string `json:"external_labels,omitempty"`
+
+	// WAL specifies the Write-Ahead Log settings of the exporter.
+	//
+	// +k8s:optional
+	WAL PrometheusRemoteWriteWALConfig `json:"wal,omitzero"`
+
+	// RetryOnFailure specifies the retry policy of the exporter.
+	//
+	// +k8s:optional
+	RetryOnFailure RetryOnFailureConfig `json:"retry_on_failure,omitzero"`
+}
+
+// KafkaEncoding specifies the encoding of the messages sent to Kafka.
+//
+// +k8s:enum
+type KafkaEncoding string
+
+const (
+	// KafkaEncodingOTLPProto specifies that the messages are encoded as OTLP
+	// Protobuf.
+	KafkaEncodingOTLPProto KafkaEncoding = "otlp_proto"
+	// KafkaEncodingOTLPJSON specifies that the messages are encoded as OTLP
+	// JSON.
+	KafkaEncodingOTLPJSON KafkaEncoding = "otlp_json"
+)
+
+// KafkaSASLMechanism specifies the SASL mechanism used to authenticate with
+// the Kafka brokers.
+//
+// +k8s:enum
+type KafkaSASLMechanism string
+
+const (
+	// KafkaSASLMechanismPlain specifies the PLAIN SASL mechanism.
+	KafkaSASLMechanismPlain KafkaSASLMechanism = "PLAIN"
+	// KafkaSASLMechanismSCRAMSHA256 specifies the SCRAM-SHA-256 SASL
+	// mechanism.
+	KafkaSASLMechanismSCRAMSHA256 KafkaSASLMechanism = "SCRAM-SHA-256"
+	// KafkaSASLMechanismSCRAMSHA512 specifies the SCRAM-SHA-512 SASL
+	// mechanism.
+	KafkaSASLMechanismSCRAMSHA512 KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+// KafkaTopicsConfig provides the Kafka topics, to which the signals are
+// exported.
+type KafkaTopicsConfig struct {
+	// Logs specifies the topic for logs. The default value is
+	// [DefaultKafkaLogsTopic].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultKafkaLogsTopic)
+	Logs string `json:"logs,omitzero"`
+
+	// Metrics specifies the topic for metrics. The default value is
+	// [DefaultKafkaMetricsTopic].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultKafkaMetricsTopic)
+	Metrics string `json:"metrics,omitzero"`
+
+	// Traces specifies the topic for traces. The default value is
+	// [DefaultKafkaTracesTopic].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultKafkaTracesTopic)
+	Traces string `json:"traces,omitzero"`
+}
+
+// KafkaSASLConfig provides the SASL authentication settings of the Kafka
+// exporter.
+type KafkaSASLConfig struct {
+	// Mechanism specifies the SASL mechanism. The default value is
+	// [KafkaSASLMechanismSCRAMSHA512].
+	//
+	// +k8s:optional
+	// +default=ref(KafkaSASLMechanismSCRAMSHA512)
+	Mechanism KafkaSASLMechanism `json:"mechanism,omitzero"`
+
+	// Username references the username used for authentication.
+	//
+	// +k8s:required
+	Username *ResourceReference `json:"username,omitzero"`
+
+	// Password references the password used for authentication.
+	//
+	// +k8s:required
+	Password *ResourceReference `json:"password,omitzero"`
+}
+
+// KafkaExporterConfig provides the Kafka Exporter config settings.
+//
+// See [Kafka Exporter] for more details.
+//
+// [Kafka Exporter]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/kafkaexporter
+type KafkaExporterConfig struct {
+	// Enabled specifies whether the Kafka exporter is enabled or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Brokers specifies the addresses of the Kafka brokers in the
+	// host:port format.
+	//
+	// +k8s:required
+	Brokers []string `json:"brokers,omitempty"`
+
+	// Topics specifies the topics, to which the signals are exported.
+	//
+	// +k8s:optional
+	Topics KafkaTopicsConfig `json:"topics,omitzero"`
+
+	// Encoding specifies the encoding of the messages. The default value is
+	// [KafkaEncodingOTLPProto].
+	//
+	// +k8s:optional
+	// +default=ref(KafkaEncodingOTLPProto)
+	Encoding KafkaEncoding `json:"encoding,omitzero"`
+
+	// SASL specifies the SASL authentication settings of the exporter.
+	//
+	// +k8s:optional
+	SASL *KafkaSASLConfig `json:"sasl,omitzero"`
+
+	// TLS specifies the TLS configuration settings for the exporter.
+	//
+	// +k8s:optional
+	TLS *TLSConfig `json:"tls,omitzero"`
+
+	// Timeout specifies the time to wait per individual attempt to send
+	// data to the brokers. The default value is
+	// [DefaultKafkaExporterTimeout].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultKafkaExporterTimeout)
+	Timeout time.Duration `json:"timeout,omitzero"`
+
+	// RetryOnFailure specifies the retry policy of the exporter.
+	//
+	// +k8s:optional
+	RetryOnFailure RetryOnFailureConfig `json:"retry_on_failure,omitzero"`
+}
+
+// NamedOTLPHTTPExporterConfig provides the settings for a named OTLP HTTP
+// exporter.
+//
+// In contrast to the singleton exporter, a named exporter is enabled by
+// default, i.e. unless Enabled is explicitly set to false.
+type NamedOTLPHTTPExporterConfig struct {
+	// Name specifies the name of the exporter, which must be a valid DNS
+	// label. The exporter can be referenced by the pipelines as
+	// otlp_http/<name>.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// OTLPHTTPExporterConfig provides the OTLP HTTP Exporter settings.
+	OTLPHTTPExporterConfig `json:",inline"`
+}
+
+// NamedOTLPGRPCExporterConfig provides the settings for a named OTLP gRPC
+// exporter.
+//
+// In contrast to the singleton exporter, a named exporter is enabled by
+// default, i.e. unless Enabled is explicitly set to false.
+type NamedOTLPGRPCExporterConfig struct {
+	// Name specifies the name of the exporter, which must be a valid DNS
+	// label. The exporter can be referenced by the pipelines as
+	// otlp_grpc/<name>.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// OTLPGRPCExporterConfig provides the OTLP gRPC Exporter settings.
+	OTLPGRPCExporterConfig `json:",inline"`
+}
+
 // CollectorExportersConfig provides the OTLP exporter settings.
 type CollectorExportersConfig struct {
 	// OTLPGRPCExporter provides the OTLP gRPC Exporter settings.
@@ -396,6 +840,29 @@ type CollectorExportersConfig struct {
 	//
 	// +k8s:optional
 	DebugExporter DebugExporterConfig `json:"debug,omitzero"`
+
+	// PrometheusRemoteWriteExporter provides the settings for the
+	// Prometheus Remote Write exporter.
+	//
+	// +k8s:optional
+	PrometheusRemoteWriteExporter PrometheusRemoteWriteExporterConfig `json:"prometheusremotewrite,omitzero"`
+
+	// KafkaExporter provides the settings for the Kafka exporter.
+	//
+	// +k8s:optional
+	KafkaExporter KafkaExporterConfig `json:"kafka,omitzero"`
+
+	// NamedOTLPGRPCExporters provides the settings for additional OTLP
+	// gRPC exporters, each of which is identified by its name.
+	//
+	// +k8s:optional
+	NamedOTLPGRPCExporters []NamedOTLPGRPCExporterConfig `json:"named_otlp_grpc,omitempty"`
+
+	// NamedOTLPHTTPExporters provides the settings for additional OTLP
+	// HTTP exporters, each of which is identified by its name.
+	//
+	// +k8s:optional
+	NamedOTLPHTTPExporters []NamedOTLPHTTPExporterConfig `json:"named_otlp_http,omitempty"`
 }
 
 // CollectorLogsConfig provides the settings for the collector internal logs.
@@ -417,46 +884,827 @@ type CollectorLogsConfig struct {
 	Encoding LogEncoding `json:"encoding,omitzero"`
 }
 
-// CollectorMetricsConfig provides the settings for the collector internal
-// metrics.
+// MetricsScrapeConfig provides the settings for selecting and scraping the
+// metrics endpoints of the control-plane components via the Target Allocator.
+type MetricsScrapeConfig struct {
+	// Interval specifies the interval, in which the targets of monitors
+	// without an explicit interval are scraped. The default value is
+	// [DefaultScrapeInterval].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultScrapeInterval)
+	Interval time.Duration `json:"interval,omitzero"`
+
+	// AllocationStrategy specifies how the Target Allocator distributes the
+	// scrape targets among the collector replicas.
+	//
+	// +k8s:optional
+	// +default=ref(AllocationStrategyConsistentHashing)
+	AllocationStrategy AllocationStrategy `json:"allocation_strategy,omitzero"`
+
+	// ServiceMonitorSelector specifies the label selector for the
+	// ServiceMonitors in the shoot control-plane namespace, which are
+	// picked up. When not specified, the ServiceMonitors with the
+	// `prometheus: shoot` label are picked up.
+	//
+	// +k8s:optional
+	ServiceMonitorSelector *metav1.LabelSelector `json:"service_monitor_selector,omitempty"`
+
+	// PodMonitorSelector specifies the label selector for the PodMonitors in
+	// the shoot control-plane namespace, which are picked up. When not
+	// specified, no PodMonitors are picked up.
+	//
+	// +k8s:optional
+	PodMonitorSelector *metav1.LabelSelector `json:"pod_monitor_selector,omitempty"`
+
+	// ScrapeConfigSelector specifies the label selector for the
+	// ScrapeConfigs in the shoot control-plane namespace, which are picked
+	// up. When not specified, no ScrapeConfigs are picked up.
+	//
+	// +k8s:optional
+	ScrapeConfigSelector *metav1.LabelSelector `json:"scrape_config_selector,omitempty"`
+
+	// MetricRelabelConfigs specifies the metric relabeling rules, which are
+	// applied to the samples of all targets handed out by the Target
+	// Allocator, e.g. in order to drop series or to rename labels, before
+	// they are sent to the backends.
+	//
+	// +k8s:optional
+	MetricRelabelConfigs []RelabelConfig `json:"metric_relabel_configs,omitempty"`
+}
+
+// RelabelConfig provides the settings for a Prometheus relabeling rule.
 //
-// See [Metrics verbosity] for more details.
+// See the link below for more details.
 //
-// [Metrics verbosity]: https://opentelemetry.io/docs/collector/internal-telemetry/#metric-verbosity
-type CollectorMetricsConfig struct {
-	// Level specifies the collector internal metrics verbosity level.
+// https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+type RelabelConfig struct {
+	// SourceLabels specifies the labels, whose values are concatenated and
+	// matched against the regex.
 	//
 	// +k8s:optional
-	// +default=ref(MetricsVerbosityLevelNormal)
-	Level MetricsVerbosityLevel `json:"level,omitzero"`
-}
+	SourceLabels []string `json:"source_labels,omitempty"`
 
-// CollectorConfigSpec specifies the desired state of [CollectorConfig]
-type CollectorConfigSpec struct {
-	// Exporters specifies the exporters configuration of the collector.
+	// Separator specifies the separator placed between the concatenated
+	// source label values. When not specified, the Prometheus default `;`
+	// is used.
 	//
-	// +k8s:required
-	Exporters CollectorExportersConfig `json:"exporters,omitzero"`
+	// +k8s:optional
+	Separator *string `json:"separator,omitempty"`
 
-	// Logs specifies the settings for the collector logs.
+	// TargetLabel specifies the label, to which the resulting value is
+	// written.
 	//
 	// +k8s:optional
-	Logs CollectorLogsConfig `json:"logs,omitzero"`
+	TargetLabel string `json:"target_label,omitzero"`
 
-	// Metrics specifies the settings for the internal collector metrics.
+	// Regex specifies the RE2 regular expression, against which the
+	// concatenated source label values are matched. When not specified, the
+	// Prometheus default `(.*)` is used.
 	//
 	// +k8s:optional
-	Metrics CollectorMetricsConfig `json:"metrics,omitzero"`
-}
+	Regex string `json:"regex,omitzero"`
 
-// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+	// Modulus specifies the modulus to take of the hash of the concatenated
+	// source label values. It is required for the `hashmod` action.
+	//
+	// +k8s:optional
+	Modulus uint64 `json:"modulus,omitzero"`
 
-// CollectorConfig provides the OpenTelemetry Collector API configuration.
-type CollectorConfig struct {
-	metav1.TypeMeta `json:",inline"`
+	// Replacement specifies the replacement value, against which a regex
+	// replace is performed, if the regex matches. When not specified, the
+	// Prometheus default `$1` is used.
+	//
+	// +k8s:optional
+	Replacement *string `json:"replacement,omitempty"`
 
-	// Spec provides the extension configuration spec.
-	Spec CollectorConfigSpec `json:"spec,omitzero"`
+	// Action specifies the action to perform based on the regex matching.
+	//
+	// +k8s:optional
+	// +default=ref(RelabelActionReplace)
+	Action RelabelAction `json:"action,omitzero"`
+}
+
+// CollectorMetricsConfig provides the settings for the collector internal
+// metrics and for scraping the metrics of the control-plane components.
+//
+// See [Metrics verbosity] for more details.
+//
+// [Metrics verbosity]: https://opentelemetry.io/docs/collector/internal-telemetry/#metric-verbosity
+type CollectorMetricsConfig struct {
+	// Level specifies the collector internal metrics verbosity level.
+	//
+	// +k8s:optional
+	// +default=ref(MetricsVerbosityLevelNormal)
+	Level MetricsVerbosityLevel `json:"level,omitzero"`
+
+	// Scrape specifies the settings for scraping the metrics of the
+	// control-plane components.
+	//
+	// +k8s:optional
+	Scrape MetricsScrapeConfig `json:"scrape,omitzero"`
+}
+
+// PipelineConfig provides the settings for a collector pipeline, which is
+// enabled by default.
+type PipelineConfig struct {
+	// Enabled specifies whether the pipeline is enabled or not.
+	//
+	// +k8s:optional
+	// +default=true
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Exporters specifies the names of the exporters, which receive the
+	// signals of the pipeline, e.g. otlp_grpc. When empty, the signals are
+	// sent to all enabled exporters.
+	//
+	// +k8s:optional
+	Exporters []string `json:"exporters,omitempty"`
+}
+
+// TracesPipelineConfig provides the settings for the traces pipeline of the
+// collector, which is disabled by default.
+type TracesPipelineConfig struct {
+	// Enabled specifies whether the traces pipeline is enabled or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Exporters specifies the names of the exporters, which receive the
+	// signals of the pipeline, e.g. otlp_grpc. When empty, the signals are
+	// sent to all enabled exporters.
+	//
+	// +k8s:optional
+	Exporters []string `json:"exporters,omitempty"`
+}
+
+// CollectorPipelinesConfig provides the settings for the signal pipelines of
+// the collector.
+type CollectorPipelinesConfig struct {
+	// Logs provides the settings for the logs pipeline, which receives
+	// logs via OTLP.
+	//
+	// +k8s:optional
+	Logs PipelineConfig `json:"logs,omitzero"`
+
+	// Events provides the settings for the events pipeline, which collects
+	// the Kubernetes events from the shoot cluster.
+	//
+	// +k8s:optional
+	Events PipelineConfig `json:"events,omitzero"`
+
+	// Metrics provides the settings for the metrics pipeline, which
+	// scrapes the targets discovered by the Target Allocator.
+	//
+	// +k8s:optional
+	Metrics PipelineConfig `json:"metrics,omitzero"`
+
+	// Traces provides the settings for the traces pipeline, which receives
+	// traces via OTLP.
+	//
+	// +k8s:optional
+	Traces TracesPipelineConfig `json:"traces,omitzero"`
+}
+
+// ResourceAttributesConfig provides the settings for the resource attributes,
+// which the collector adds to the telemetry data.
+//
+// In addition to the attributes configured here, the collector adds
+// attributes derived from the shoot, e.g. the name of the seed, the region,
+// the provider type and the Kubernetes version of the shoot.
+type ResourceAttributesConfig struct {
+	// Static specifies additional attributes with static values. Keys in
+	// the `gardener.` namespace and `k8s.cluster.name` are reserved for the
+	// attributes derived from the shoot.
+	//
+	// +k8s:optional
+	Static map[string]string `json:"static,omitempty"`
+
+	// ShootLabels specifies the keys of the shoot labels, which are added
+	// as `gardener.shoot.label.<key>` attributes, when present.
+	//
+	// +k8s:optional
+	ShootLabels []string `json:"shoot_labels,omitempty"`
+
+	// ShootAnnotations specifies the keys of the shoot annotations, which
+	// are added as `gardener.shoot.annotation.<key>` attributes, when
+	// present.
+	//
+	// +k8s:optional
+	ShootAnnotations []string `json:"shoot_annotations,omitempty"`
+}
+
+// FilterErrorMode specifies how the filter processors handle errors, which
+// occur while evaluating the conditions.
+//
+// +k8s:enum
+type FilterErrorMode string
+
+const (
+	// FilterErrorModeIgnore specifies that errors are logged and the
+	// telemetry data is kept.
+	FilterErrorModeIgnore FilterErrorMode = "ignore"
+	// FilterErrorModeSilent specifies that errors are not logged and the
+	// telemetry data is kept.
+	FilterErrorModeSilent FilterErrorMode = "silent"
+	// FilterErrorModePropagate specifies that errors are returned up the
+	// pipeline, which results in the telemetry data being dropped.
+	FilterErrorModePropagate FilterErrorMode = "propagate"
+)
+
+// LogsFilterConfig provides the OTTL conditions for dropping log records. A
+// log record is dropped when any of the conditions matches.
+type LogsFilterConfig struct {
+	// LogRecord specifies the conditions evaluated against log records,
+	// e.g. `severity_number < SEVERITY_NUMBER_INFO`.
+	//
+	// +k8s:optional
+	LogRecord []string `json:"log_record,omitempty"`
+}
+
+// MetricsFilterConfig provides the OTTL conditions for dropping metrics and
+// data points. A metric or data point is dropped when any of the conditions
+// matches.
+type MetricsFilterConfig struct {
+	// Metric specifies the conditions evaluated against metrics, e.g.
+	// `name == "apiserver_request_duration_seconds_bucket"`.
+	//
+	// +k8s:optional
+	Metric []string `json:"metric,omitempty"`
+
+	// DataPoint specifies the conditions evaluated against data points,
+	// e.g. `attributes["resource"] == "events"`.
+	//
+	// +k8s:optional
+	DataPoint []string `json:"datapoint,omitempty"`
+}
+
+// TracesFilterConfig provides the OTTL conditions for dropping spans and span
+// events. A span or span event is dropped when any of the conditions matches.
+type TracesFilterConfig struct {
+	// Span specifies the conditions evaluated against spans.
+	//
+	// +k8s:optional
+	Span []string `json:"span,omitempty"`
+
+	// SpanEvent specifies the conditions evaluated against span events.
+	//
+	// +k8s:optional
+	SpanEvent []string `json:"spanevent,omitempty"`
+}
+
+// FiltersConfig provides the settings for dropping telemetry data before it
+// is exported.
+//
+// See [Filter Processor] for more details.
+//
+// [Filter Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/filterprocessor
+type FiltersConfig struct {
+	// ErrorMode specifies how errors, which occur while evaluating the
+	// conditions, are handled. The default value is [FilterErrorModeIgnore].
+	//
+	// +k8s:optional
+	// +default=ref(FilterErrorModeIgnore)
+	ErrorMode FilterErrorMode `json:"error_mode,omitzero"`
+
+	// Logs specifies the conditions for the logs pipeline.
+	//
+	// +k8s:optional
+	Logs LogsFilterConfig `json:"logs,omitzero"`
+
+	// Metrics specifies the conditions for the metrics pipeline.
+	//
+	// +k8s:optional
+	Metrics MetricsFilterConfig `json:"metrics,omitzero"`
+
+	// Traces specifies the conditions for the traces pipeline.
+	//
+	// +k8s:optional
+	Traces TracesFilterConfig `json:"traces,omitzero"`
+}
+
+// ProbabilisticSamplerConfig provides the settings for the probabilistic
+// sampler of the traces pipeline.
+//
+// See [Probabilistic Sampling Processor] for more details.
+//
+// [Probabilistic Sampling Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/probabilisticsamplerprocessor
+type ProbabilisticSamplerConfig struct {
+	// Enabled specifies whether the probabilistic sampler is enabled or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// SamplingPercentage specifies the percentage of traces, which are
+	// sampled, e.g. 10 for 10% of the traces.
+	//
+	// +k8s:optional
+	// +default=ref(DefaultSamplingPercentage)
+	SamplingPercentage float64 `json:"sampling_percentage,omitzero"`
+}
+
+// TailSamplingPolicyType specifies the type of a tail sampling policy.
+//
+// +k8s:enum
+type TailSamplingPolicyType string
+
+const (
+	// TailSamplingPolicyTypeLatency specifies a policy, which samples
+	// traces based on their duration.
+	TailSamplingPolicyTypeLatency TailSamplingPolicyType = "latency"
+	// TailSamplingPolicyTypeStatusCode specifies a policy, which samples
+	// traces based on the status code of their spans.
+	TailSamplingPolicyTypeStatusCode TailSamplingPolicyType = "status_code"
+	// TailSamplingPolicyTypeStringAttribute specifies a policy, which
+	// samples traces based on the values of a string attribute.
+	TailSamplingPolicyTypeStringAttribute TailSamplingPolicyType = "string_attribute"
+)
+
+// LatencyPolicyConfig provides the settings for a latency tail sampling
+// policy.
+type LatencyPolicyConfig struct {
+	// ThresholdMs specifies the lower bound of the trace duration in
+	// milliseconds, above which traces are sampled.
+	//
+	// +k8s:required
+	ThresholdMs int64 `json:"threshold_ms,omitzero"`
+
+	// UpperThresholdMs specifies the upper bound of the trace duration in
+	// milliseconds. When specified, only traces with a duration between
+	// both thresholds are sampled.
+	//
+	// +k8s:optional
+	UpperThresholdMs int64 `json:"upper_threshold_ms,omitzero"`
+}
+
+// StatusCodePolicyConfig provides the settings for a status code tail
+// sampling policy.
+type StatusCodePolicyConfig struct {
+	// StatusCodes specifies the span status codes, i.e. OK, ERROR or UNSET,
+	// for which traces are sampled.
+	//
+	// +k8s:required
+	StatusCodes []string `json:"status_codes,omitempty"`
+}
+
+// StringAttributePolicyConfig provides the settings for a string attribute
+// tail sampling policy.
+type StringAttributePolicyConfig struct {
+	// Key specifies the key of the attribute.
+	//
+	// +k8s:required
+	Key string `json:"key"`
+
+	// Values specifies the values of the attribute, for which traces are
+	// sampled.
+	//
+	// +k8s:required
+	Values []string `json:"values,omitempty"`
+
+	// EnabledRegexMatching specifies whether the values are regular
+	// expressions.
+	//
+	// +k8s:optional
+	EnabledRegexMatching bool `json:"enabled_regex_matching,omitzero"`
+
+	// InvertMatch specifies whether the match is inverted, i.e. traces are
+	// sampled when the attribute does not match the values.
+	//
+	// +k8s:optional
+	InvertMatch bool `json:"invert_match,omitzero"`
+}
+
+// TailSamplingPolicy provides the settings for a tail sampling policy. Only
+// the settings for the type of the policy are taken into account.
+type TailSamplingPolicy struct {
+	// Name specifies the name of the policy.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// Type specifies the type of the policy.
+	//
+	// +k8s:required
+	Type TailSamplingPolicyType `json:"type"`
+
+	// Latency provides the settings for a latency policy.
+	//
+	// +k8s:optional
+	Latency *LatencyPolicyConfig `json:"latency,omitzero"`
+
+	// StatusCode provides the settings for a status code policy.
+	//
+	// +k8s:optional
+	StatusCode *StatusCodePolicyConfig `json:"status_code,omitzero"`
+
+	// StringAttribute provides the settings for a string attribute policy.
+	//
+	// +k8s:optional
+	StringAttribute *StringAttributePolicyConfig `json:"string_attribute,omitzero"`
+}
+
+// TailSamplingConfig provides the settings for the tail sampling of the
+// traces pipeline. A trace is sampled when any of the policies samples it.
+//
+// See [Tail Sampling Processor] for more details.
+//
+// [Tail Sampling Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/tailsamplingprocessor
+type TailSamplingConfig struct {
+	// Enabled specifies whether the tail sampling is enabled or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// DecisionWait specifies the time to wait since the first span of a
+	// trace, before making a sampling decision. The default value is
+	// [DefaultTailSamplingDecisionWait].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultTailSamplingDecisionWait)
+	DecisionWait time.Duration `json:"decision_wait,omitzero"`
+
+	// NumTraces specifies the number of traces kept in memory. The default
+	// value is [DefaultTailSamplingNumTraces].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultTailSamplingNumTraces)
+	NumTraces int `json:"num_traces,omitzero"`
+
+	// Policies specifies the policies, which decide whether a trace is
+	// sampled.
+	//
+	// +k8s:optional
+	Policies []TailSamplingPolicy `json:"policies,omitempty"`
+}
+
+// SamplingConfig provides the sampling settings for the traces pipeline.
+type SamplingConfig struct {
+	// Probabilistic provides the settings for the probabilistic sampler.
+	//
+	// +k8s:optional
+	Probabilistic ProbabilisticSamplerConfig `json:"probabilistic,omitzero"`
+
+	// TailSampling provides the settings for the tail sampling.
+	//
+	// +k8s:optional
+	TailSampling TailSamplingConfig `json:"tail_sampling,omitzero"`
+}
+
+// CollectorStorageConfig provides the settings for the persistent volume of
+// the collector, which backs the file storage of the sending queues.
+type CollectorStorageConfig struct {
+	// Size specifies the size of the persistent volume. The default value
+	// is 1Gi.
+	//
+	// +k8s:optional
+	// +default="1Gi"
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// StorageClassName specifies the name of the storage class of the
+	// persistent volume. When not specified, the default storage class is
+	// used.
+	//
+	// +k8s:optional
+	StorageClassName *string `json:"storage_class_name,omitempty"`
+}
+
+// AutoscalingConfig provides the settings of the horizontal pod autoscaler of
+// the collector.
+type AutoscalingConfig struct {
+	// MinReplicas specifies the lower limit for the number of replicas. The
+	// default value is 1.
+	//
+	// +k8s:optional
+	// +default=1
+	MinReplicas *int32 `json:"min_replicas,omitempty"`
+
+	// MaxReplicas specifies the upper limit for the number of replicas.
+	//
+	// +k8s:required
+	MaxReplicas int32 `json:"max_replicas"`
+
+	// TargetCPUUtilization specifies the target average CPU utilization in
+	// percent of the requested CPU. When neither the CPU nor the memory
+	// utilization is specified, the CPU utilization target defaults to 80.
+	//
+	// +k8s:optional
+	TargetCPUUtilization *int32 `json:"target_cpu_utilization,omitempty"`
+
+	// TargetMemoryUtilization specifies the target average memory
+	// utilization in percent of the requested memory.
+	//
+	// +k8s:optional
+	TargetMemoryUtilization *int32 `json:"target_memory_utilization,omitempty"`
+}
+
+// CollectorScalingConfig provides the scaling settings of the collector.
+type CollectorScalingConfig struct {
+	// Replicas specifies the number of replicas of the collector. The scrape
+	// targets are distributed among the replicas by the Target Allocator.
+	// The setting is ignored, when autoscaling is configured. The default
+	// value is 1.
+	//
+	// +k8s:optional
+	// +default=1
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources specifies the compute resources of the collector
+	// container. When not specified, 10m CPU and 50Mi memory are requested.
+	//
+	// +k8s:optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Autoscaling specifies the settings of the horizontal pod autoscaler of
+	// the collector. When not specified, the collector is not autoscaled.
+	//
+	// +k8s:optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+}
+
+// TargetAllocatorScalingConfig provides the scaling settings of the Target
+// Allocator.
+type TargetAllocatorScalingConfig struct {
+	// Replicas specifies the number of replicas of the Target Allocator. The
+	// default value is 1.
+	//
+	// +k8s:optional
+	// +default=1
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources specifies the compute resources of the Target Allocator
+	// container. When not specified, 10m CPU and 50Mi memory are requested.
+	//
+	// +k8s:optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ScalingConfig provides the scaling settings of the collector and the Target
+// Allocator. A PodDisruptionBudget is rendered for each of them, when it runs
+// with more than one replica.
+type ScalingConfig struct {
+	// Collector specifies the scaling settings of the collector.
+	//
+	// +k8s:optional
+	Collector CollectorScalingConfig `json:"collector,omitzero"`
+
+	// TargetAllocator specifies the scaling settings of the Target
+	// Allocator.
+	//
+	// +k8s:optional
+	TargetAllocator TargetAllocatorScalingConfig `json:"target_allocator,omitzero"`
+}
+
+// KubernetesObjectConfig provides the settings for collecting a kind of
+// Kubernetes objects from the shoot cluster.
+//
+// See [Kubernetes Objects Receiver] for more details.
+//
+// [Kubernetes Objects Receiver]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/k8sobjectsreceiver
+type KubernetesObjectConfig struct {
+	// Name specifies the plural name of the resource, e.g. pods.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// Group specifies the API group of the resource, e.g. events.k8s.io.
+	// When empty, the resource belongs to the core API group.
+	//
+	// +k8s:optional
+	Group string `json:"group,omitzero"`
+
+	// Mode specifies whether the objects are pulled periodically or watched.
+	//
+	// +k8s:optional
+	// +default=ref(KubernetesObjectModePull)
+	Mode KubernetesObjectMode `json:"mode,omitzero"`
+
+	// Interval specifies the interval, in which the objects are pulled. It
+	// is ignored in watch mode. The default value is
+	// [DefaultKubernetesObjectInterval].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultKubernetesObjectInterval)
+	Interval time.Duration `json:"interval,omitzero"`
+
+	// Namespaces specifies the namespaces, from which the objects are
+	// collected. When empty, the objects are collected from all namespaces.
+	// The namespaces must exist in the shoot cluster, as the collector is
+	// granted access via a Role in each of them. They must not be specified
+	// for cluster-scoped resources.
+	//
+	// +k8s:optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// LabelSelector specifies the label selector for the collected objects,
+	// e.g. `environment=production`.
+	//
+	// +k8s:optional
+	LabelSelector string `json:"label_selector,omitzero"`
+
+	// FieldSelector specifies the field selector for the collected objects,
+	// e.g. `status.phase=Running`.
+	//
+	// +k8s:optional
+	FieldSelector string `json:"field_selector,omitzero"`
+}
+
+// EventsConfig provides the settings for filtering the Kubernetes events,
+// which are collected from the shoot cluster by the events pipeline.
+type EventsConfig struct {
+	// Namespaces specifies the namespaces, from which the events are
+	// collected. When empty, the events are collected from all namespaces.
+	// The namespaces must exist in the shoot cluster.
+	//
+	// +k8s:optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ExcludeNamespaces specifies the namespaces, whose events are dropped.
+	//
+	// +k8s:optional
+	ExcludeNamespaces []string `json:"exclude_namespaces,omitempty"`
+
+	// Types specifies the types of the events, which are kept. When empty,
+	// the events of all types are kept.
+	//
+	// +k8s:optional
+	Types []EventType `json:"types,omitempty"`
+
+	// Reasons specifies the reasons of the events, which are kept, e.g.
+	// `FailedScheduling`. When empty, the events with any reason are kept.
+	//
+	// +k8s:optional
+	Reasons []string `json:"reasons,omitempty"`
+
+	// ExcludeReasons specifies the reasons of the events, which are
+	// dropped, e.g. `Pulled`.
+	//
+	// +k8s:optional
+	ExcludeReasons []string `json:"exclude_reasons,omitempty"`
+
+	// OnlyNewEvents specifies whether only the creation of the watched
+	// events is collected, but neither their updates nor their deletion.
+	// Kubernetes aggregates repeated occurrences into the same event by
+	// updating its count, hence the recurrences of an event are dropped as
+	// well, including the ones of Warning events.
+	//
+	// +k8s:optional
+	// +default=false
+	OnlyNewEvents *bool `json:"only_new_events,omitzero"`
+}
+
+// CollectorConfigSpec specifies the desired state of [CollectorConfig]
+type CollectorConfigSpec struct {
+	// Exporters specifies the exporters configuration of the collector.
+	//
+	// +k8s:required
+	Exporters CollectorExportersConfig `json:"exporters,omitzero"`
+
+	// Pipelines specifies the settings for the signal pipelines of the
+	// collector.
+	//
+	// +k8s:optional
+	Pipelines CollectorPipelinesConfig `json:"pipelines,omitzero"`
+
+	// KubernetesObjects specifies the Kubernetes objects, which are collected
+	// from the shoot cluster by the events pipeline. When empty, the events
+	// of the events.k8s.io API group are watched in all namespaces.
+	//
+	// +k8s:optional
+	KubernetesObjects []KubernetesObjectConfig `json:"kubernetes_objects,omitempty"`
+
+	// Events specifies the settings for filtering the Kubernetes events,
+	// which are collected from the shoot cluster.
+	//
+	// +k8s:optional
+	Events EventsConfig `json:"events,omitzero"`
+
+	// ResourceAttributes specifies the settings for the resource attributes,
+	// which are added to the telemetry data.
+	//
+	// +k8s:optional
+	ResourceAttributes ResourceAttributesConfig `json:"resource_attributes,omitzero"`
+
+	// Sampling specifies the sampling settings for the traces pipeline.
+	//
+	// +k8s:optional
+	Sampling SamplingConfig `json:"sampling,omitzero"`
+
+	// Filters specifies the conditions for dropping telemetry data before
+	// it is exported.
+	//
+	// +k8s:optional
+	Filters FiltersConfig `json:"filters,omitzero"`
+
+	// Storage specifies the settings for the persistent volume of the
+	// collector, which is used when the sending queue of an exporter is
+	// stored in the file storage.
+	//
+	// +k8s:optional
+	Storage CollectorStorageConfig `json:"storage,omitzero"`
+
+	// Scaling specifies the replicas, compute resources and autoscaling
+	// settings of the collector and the Target Allocator.
+	//
+	// +k8s:optional
+	Scaling ScalingConfig `json:"scaling,omitzero"`
+
+	// Logs specifies the settings for the collector logs.
+	//
+	// +k8s:optional
+	Logs CollectorLogsConfig `json:"logs,omitzero"`
+
+	// Metrics specifies the settings for the internal collector metrics.
+	//
+	// +k8s:optional
+	Metrics CollectorMetricsConfig `json:"metrics,omitzero"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CollectorConfig provides the OpenTelemetry Collector API configuration.
+type CollectorConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Spec provides the extension configuration spec.
+	Spec CollectorConfigSpec `json:"spec,omitzero"`
+}
+
+// ExporterStatus provides the status of an enabled exporter.
+type ExporterStatus struct {
+	// Name specifies the name of the exporter, as referenced in the
+	// collector configuration, e.g. otlp_http or otlp_grpc/<name>.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// Endpoint specifies the endpoint to which the exporter sends data. For
+	// the Kafka exporter it contains the comma-separated brokers.
+	//
+	// +k8s:optional
+	Endpoint string `json:"endpoint,omitzero"`
+}
+
+// CertificateStatus provides the status of a certificate, which is managed
+// by the extension.
+type CertificateStatus struct {
+	// Name specifies the name of the certificate.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// NotAfter specifies the time after which the certificate expires.
+	//
+	// +k8s:required
+	NotAfter metav1.Time `json:"not_after"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CollectorStatus provides the status of the OpenTelemetry Collector managed
+// by the extension, which is written to the provider status of the
+// Extension resource.
+type CollectorStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Pipelines specifies the names of the enabled pipelines.
+	//
+	// +k8s:optional
+	Pipelines []string `json:"pipelines,omitempty"`
+
+	// Exporters provides the status of the enabled exporters.
+	//
+	// +k8s:optional
+	Exporters []ExporterStatus `json:"exporters,omitempty"`
+
+	// ConfigHash specifies the SHA-256 hash of the rendered collector
+	// configuration.
+	//
+	// +k8s:optional
+	ConfigHash string `json:"config_hash,omitzero"`
+
+	// CollectorVersion specifies the image version of the OpenTelemetry
+	// Collector.
+	//
+	// +k8s:optional
+	CollectorVersion string `json:"collector_version,omitzero"`
+
+	// TargetAllocatorVersion specifies the image version of the Target
+	// Allocator.
+	//
+	// +k8s:optional
+	TargetAllocatorVersion string `json:"target_allocator_version,omitzero"`
+
+	// Certificates provides the status of the currently active certificates
+	// used for the communication between the collector and the Target
+	// Allocator. Only the certificates generated by the extension are
+	// included, the certificates in the secrets referenced by the TLS
+	// settings of the exporters are not reported.
+	//
+	// +k8s:optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 }
 
 // TLSConfig provides the TLS settings used by exporters.
@@ -6,55 +6,294 @@ package validation
 
 import (
 	"cmp"
+	"context"
+	"errors"
+	"maps"
+	"net"
 	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/relabel"
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+	"go.yaml.in/yaml/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
 )
 
+// prometheusLabelNameRegexp matches valid Prometheus label names.
+var prometheusLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// kafkaTopicRegexp matches valid Kafka topic names.
+var kafkaTopicRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// maxKafkaTopicLength specifies the max length of a Kafka topic name.
+const maxKafkaTopicLength = 249
+
+// clusterScopedResources contains the cluster-scoped resources of the
+// built-in Kubernetes APIs, which cannot be collected from specific
+// namespaces.
+var clusterScopedResources = map[schema.GroupResource]bool{
+	{Group: "", Resource: "componentstatuses"}:                                           true,
+	{Group: "", Resource: "namespaces"}:                                                  true,
+	{Group: "", Resource: "nodes"}:                                                       true,
+	{Group: "", Resource: "persistentvolumes"}:                                           true,
+	{Group: "admissionregistration.k8s.io", Resource: "mutatingwebhookconfigurations"}:   true,
+	{Group: "admissionregistration.k8s.io", Resource: "validatingadmissionpolicies"}:     true,
+	{Group: "admissionregistration.k8s.io", Resource: "validatingwebhookconfigurations"}: true,
+	{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}:               true,
+	{Group: "apiregistration.k8s.io", Resource: "apiservices"}:                           true,
+	{Group: "certificates.k8s.io", Resource: "certificatesigningrequests"}:               true,
+	{Group: "flowcontrol.apiserver.k8s.io", Resource: "flowschemas"}:                     true,
+	{Group: "flowcontrol.apiserver.k8s.io", Resource: "prioritylevelconfigurations"}:     true,
+	{Group: "networking.k8s.io", Resource: "ingressclasses"}:                             true,
+	{Group: "node.k8s.io", Resource: "runtimeclasses"}:                                   true,
+	{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}:                true,
+	{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}:                       true,
+	{Group: "scheduling.k8s.io", Resource: "priorityclasses"}:                            true,
+	{Group: "storage.k8s.io", Resource: "csidrivers"}:                                    true,
+	{Group: "storage.k8s.io", Resource: "csinodes"}:                                      true,
+	{Group: "storage.k8s.io", Resource: "storageclasses"}:                                true,
+	{Group: "storage.k8s.io", Resource: "volumeattachments"}:                             true,
+}
+
+// maxExporterNameLength specifies the max length of the name of a named
+// exporter.
+const maxExporterNameLength = 24
+
 // Validate validates the given [config.CollectorConfig]
 func Validate(cfg config.CollectorConfig) error {
 	allErrs := make(field.ErrorList, 0)
 
 	// We require at least one exporter to be enabled
-	anyExporterEnabled := []bool{
-		cfg.Spec.Exporters.DebugExporter.IsEnabled(),
-		cfg.Spec.Exporters.OTLPHTTPExporter.IsEnabled(),
-		cfg.Spec.Exporters.OTLPGRPCExporter.IsEnabled(),
+	if len(cfg.Spec.Exporters.EnabledExporterNames()) == 0 {
+		allErrs = append(
+			allErrs,
+			field.Required(field.NewPath("spec.exporters"), "no exporter enabled"),
+		)
+	}
+
+	// We require at least one pipeline to be enabled
+	anyPipelineEnabled := []bool{
+		cfg.Spec.Pipelines.Logs.IsEnabled(),
+		cfg.Spec.Pipelines.Events.IsEnabled(),
+		cfg.Spec.Pipelines.Metrics.IsEnabled(),
+		cfg.Spec.Pipelines.Traces.IsEnabled(),
 	}
 
-	if !cmp.Or(anyExporterEnabled...) {
+	if !cmp.Or(anyPipelineEnabled...) {
 		allErrs = append(
 			allErrs,
-			field.Required(field.NewPath("spec.exporters"), "no exporter enabled"),
+			field.Required(field.NewPath("spec.pipelines"), "no pipeline enabled"),
+		)
+	}
+
+	// Pipelines may only reference known exporters, which are enabled and
+	// support the signal of the pipeline
+	pipelineExporters := []struct {
+		path      string
+		enabled   bool
+		signal    config.Signal
+		exporters []string
+	}{
+		{
+			path:      "spec.pipelines.logs.exporters",
+			enabled:   cfg.Spec.Pipelines.Logs.IsEnabled(),
+			signal:    config.SignalLogs,
+			exporters: cfg.Spec.Pipelines.Logs.Exporters,
+		},
+		{
+			path:      "spec.pipelines.events.exporters",
+			enabled:   cfg.Spec.Pipelines.Events.IsEnabled(),
+			signal:    config.SignalLogs,
+			exporters: cfg.Spec.Pipelines.Events.Exporters,
+		},
+		{
+			path:      "spec.pipelines.metrics.exporters",
+			enabled:   cfg.Spec.Pipelines.Metrics.IsEnabled(),
+			signal:    config.SignalMetrics,
+			exporters: cfg.Spec.Pipelines.Metrics.Exporters,
+		},
+		{
+			path:      "spec.pipelines.traces.exporters",
+			enabled:   cfg.Spec.Pipelines.Traces.IsEnabled(),
+			signal:    config.SignalTraces,
+			exporters: cfg.Spec.Pipelines.Traces.Exporters,
+		},
+	}
+
+	knownExporters := cfg.Spec.Exporters.ExporterNames()
+	enabledExporters := cfg.Spec.Exporters.EnabledExporterNames()
+	for _, f := range pipelineExporters {
+		// Pipelines without explicit exporters send their signals to all
+		// enabled exporters supporting the signal, so there must be one.
+		if f.enabled && len(f.exporters) == 0 && len(enabledExporters) > 0 &&
+			len(cfg.Spec.Exporters.EnabledExporterNamesForSignal(f.signal)) == 0 {
+			allErrs = append(
+				allErrs,
+				field.Required(field.NewPath(f.path), "no enabled exporter supports the "+string(f.signal)+" signal"),
+			)
+		}
+
+		seen := make(map[string]bool)
+		for i, name := range f.exporters {
+			fldPath := field.NewPath(f.path).Index(i)
+			switch {
+			case !slices.Contains(knownExporters, name):
+				allErrs = append(allErrs, field.NotSupported(fldPath, name, knownExporters))
+			case !slices.Contains(enabledExporters, name):
+				allErrs = append(allErrs, field.Invalid(fldPath, name, "exporter is not enabled"))
+			case !config.ExporterSupportsSignal(name, f.signal):
+				allErrs = append(allErrs, field.Invalid(fldPath, name, "exporter does not support the "+string(f.signal)+" signal"))
+			case seen[name]:
+				allErrs = append(allErrs, field.Duplicate(fldPath, name))
+			}
+			seen[name] = true
+		}
+	}
+
+	// Validate the Kubernetes objects
+	allErrs = append(allErrs, validateKubernetesObjects(field.NewPath("spec.kubernetes_objects"), cfg.Spec.KubernetesObjects)...)
+
+	// Validate the events settings
+	allErrs = append(allErrs, validateEvents(field.NewPath("spec.events"), cfg.Spec.Events, cfg.Spec.KubernetesObjects)...)
+
+	// Validate the persistent volume settings
+	if size := cfg.Spec.Storage.Size; size != nil && size.Sign() <= 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(field.NewPath("spec.storage.size"), size.String(), "value must be positive"),
 		)
 	}
 
+	// Validate the scaling settings
+	allErrs = append(allErrs, validateScaling(field.NewPath("spec.scaling"), cfg.Spec.Scaling)...)
+
+	// Validate the resource attributes
+	allErrs = append(allErrs, validateResourceAttributes(field.NewPath("spec.resource_attributes"), cfg.Spec.ResourceAttributes)...)
+
+	// Validate the filter settings
+	allErrs = append(allErrs, validateFilters(field.NewPath("spec.filters"), cfg.Spec.Filters)...)
+
+	// Validate the scrape settings
+	allErrs = append(allErrs, validateMetricsScrape(field.NewPath("spec.metrics.scrape"), cfg.Spec.Metrics.Scrape)...)
+
+	// Validate the sampling settings
+	allErrs = append(allErrs, validateSampling(field.NewPath("spec.sampling"), cfg.Spec.Sampling)...)
+
+	// The spans of a trace must be received by the same collector for the
+	// tail sampling decisions, which cannot be ensured for multiple replicas
+	// behind the load-balanced service of the collector.
+	if cfg.Spec.Sampling.TailSampling.IsEnabled() {
+		collectorScaling := cfg.Spec.Scaling.Collector
+		if collectorScaling.Autoscaling != nil || (collectorScaling.Replicas != nil && *collectorScaling.Replicas > 1) {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(
+					field.NewPath("spec.sampling.tail_sampling.enabled"),
+					"tail sampling is not supported with more than one collector replica or autoscaling",
+				),
+			)
+		}
+	}
+
+	// Validate the exporters
+	exportersPath := field.NewPath("spec.exporters")
+	allErrs = append(allErrs, validateOTLPHTTPExporter(exportersPath.Child("otlp_http"), cfg.Spec.Exporters.OTLPHTTPExporter)...)
+	allErrs = append(allErrs, validateOTLPGRPCExporter(exportersPath.Child("otlp_grpc"), cfg.Spec.Exporters.OTLPGRPCExporter)...)
+	allErrs = append(allErrs, validatePrometheusRemoteWriteExporter(exportersPath.Child("prometheusremotewrite"), cfg.Spec.Exporters.PrometheusRemoteWriteExporter)...)
+	allErrs = append(allErrs, validateKafkaExporter(exportersPath.Child("kafka"), cfg.Spec.Exporters.KafkaExporter)...)
+
+	httpExporterNames := make(map[string]bool)
+	for i, exporter := range cfg.Spec.Exporters.NamedOTLPHTTPExporters {
+		fldPath := exportersPath.Child("named_otlp_http").Index(i)
+		allErrs = append(allErrs, validateExporterName(fldPath.Child("name"), exporter.Name, httpExporterNames)...)
+		allErrs = append(allErrs, validateOTLPHTTPExporter(fldPath, exporter.OTLPHTTPExporterConfig)...)
+	}
+
+	grpcExporterNames := make(map[string]bool)
+	for i, exporter := range cfg.Spec.Exporters.NamedOTLPGRPCExporters {
+		fldPath := exportersPath.Child("named_otlp_grpc").Index(i)
+		allErrs = append(allErrs, validateExporterName(fldPath.Child("name"), exporter.Name, grpcExporterNames)...)
+		allErrs = append(allErrs, validateOTLPGRPCExporter(fldPath, exporter.OTLPGRPCExporterConfig)...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateExporterName validates the name of a named exporter. The name is
+// used for deriving the names of the volumes for the exporter, which must be
+// valid DNS labels, hence the restriction on the length of the name.
+func validateExporterName(fldPath *field.Path, name string, seen map[string]bool) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if name == "" {
+		return append(allErrs, field.Required(fldPath, "name is empty"))
+	}
+
+	for _, msg := range validation.IsDNS1123Label(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+
+	if len(name) > maxExporterNameLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, name, maxExporterNameLength))
+	}
+
+	if seen[name] {
+		allErrs = append(allErrs, field.Duplicate(fldPath, name))
+	}
+	seen[name] = true
+
+	return allErrs
+}
+
+// validateOTLPHTTPExporter validates the given [config.OTLPHTTPExporterConfig].
+func validateOTLPHTTPExporter(fldPath *field.Path, cfg config.OTLPHTTPExporterConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
 	// Validate URL fields
 	urlFields := []struct {
-		path  string
+		path  *field.Path
 		value string
 	}{
 		{
-			path:  "spec.exporters.otlp_http.endpoint",
-			value: cfg.Spec.Exporters.OTLPHTTPExporter.Endpoint,
+			path:  fldPath.Child("endpoint"),
+			value: cfg.Endpoint,
 		},
 		{
-			path:  "spec.exporters.otlp_http.traces_endpoint",
-			value: cfg.Spec.Exporters.OTLPHTTPExporter.TracesEndpoint,
+			path:  fldPath.Child("traces_endpoint"),
+			value: cfg.TracesEndpoint,
 		},
 		{
-			path:  "spec.exporters.otlp_http.metrics_endpoint",
-			value: cfg.Spec.Exporters.OTLPHTTPExporter.MetricsEndpoint,
+			path:  fldPath.Child("metrics_endpoint"),
+			value: cfg.MetricsEndpoint,
 		},
 		{
-			path:  "spec.exporters.otlp_http.logs_endpoint",
-			value: cfg.Spec.Exporters.OTLPHTTPExporter.LogsEndpoint,
+			path:  fldPath.Child("logs_endpoint"),
+			value: cfg.LogsEndpoint,
 		},
 		{
-			path:  "spec.exporters.otlp_http.profiles_endpoint",
-			value: cfg.Spec.Exporters.OTLPHTTPExporter.ProfilesEndpoint,
+			path:  fldPath.Child("profiles_endpoint"),
+			value: cfg.ProfilesEndpoint,
 		},
 	}
 
@@ -63,137 +302,1056 @@ func Validate(cfg config.CollectorConfig) error {
 			if _, err := url.Parse(f.value); err != nil {
 				allErrs = append(
 					allErrs,
-					field.Invalid(field.NewPath(f.path), f.value, "invalid URL specified"),
+					field.Invalid(f.path, f.value, "invalid URL specified"),
 				)
 			}
 		}
 	}
 
 	// Make sure that the HTTP client read/write buffers are good
-	type nonNegativeField struct {
-		path  string
-		value int
+	allErrs = append(allErrs, validateBufferSizes(fldPath, cfg.ReadBufferSize, cfg.WriteBufferSize)...)
+
+	// Referenced resources from the OTLP HTTP exporter
+	allErrs = append(allErrs, validateResourceReferences(fldPath, cfg.Token, cfg.TLS)...)
+
+	allErrs = append(allErrs, validateSendingQueue(fldPath.Child("sending_queue"), cfg.SendingQueue)...)
+
+	return allErrs
+}
+
+// validateOTLPGRPCExporter validates the given [config.OTLPGRPCExporterConfig].
+func validateOTLPGRPCExporter(fldPath *field.Path, cfg config.OTLPGRPCExporterConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	// Make sure that the gRPC client read/write buffers are good
+	allErrs = append(allErrs, validateBufferSizes(fldPath, cfg.ReadBufferSize, cfg.WriteBufferSize)...)
+
+	// Referenced resources from the OTLP gRPC exporter
+	allErrs = append(allErrs, validateResourceReferences(fldPath, cfg.Token, cfg.TLS)...)
+
+	allErrs = append(allErrs, validateSendingQueue(fldPath.Child("sending_queue"), cfg.SendingQueue)...)
+
+	// The endpoint is required when the exporter is enabled
+	if cfg.IsEnabled() && cfg.Endpoint == "" {
+		endpointPath := fldPath.Child("endpoint")
+		allErrs = append(
+			allErrs,
+			field.Invalid(endpointPath, endpointPath.String(), "empty value specified"),
+		)
 	}
 
-	nonNegativeFields := []nonNegativeField{
-		{
-			path:  "spec.exporters.otlp_http.read_buffer_size",
-			value: cfg.Spec.Exporters.OTLPHTTPExporter.ReadBufferSize,
-		},
-		{
-			path:  "spec.exporters.otlp_http.write_buffer_size",
-			value: cfg.Spec.Exporters.OTLPHTTPExporter.WriteBufferSize,
-		},
-		{
-			path:  "spec.exporters.otlp_grpc.read_buffer_size",
-			value: cfg.Spec.Exporters.OTLPGRPCExporter.ReadBufferSize,
-		},
-		{
-			path:  "spec.exporters.otlp_grpc.write_buffer_size",
-			value: cfg.Spec.Exporters.OTLPGRPCExporter.WriteBufferSize,
-		},
+	return allErrs
+}
+
+// validatePrometheusRemoteWriteExporter validates the given
+// [config.PrometheusRemoteWriteExporterConfig].
+func validatePrometheusRemoteWriteExporter(fldPath *field.Path, cfg config.PrometheusRemoteWriteExporterConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	endpointPath := fldPath.Child("endpoint")
+
+	// The endpoint is required when the exporter is enabled
+	switch {
+	case cfg.IsEnabled() && cfg.Endpoint == "":
+		allErrs = append(
+			allErrs,
+			field.Invalid(endpointPath, endpointPath.String(), "empty value specified"),
+		)
+	case cfg.Endpoint != "":
+		if _, err := url.Parse(cfg.Endpoint); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(endpointPath, cfg.Endpoint, "invalid URL specified"),
+			)
+		}
 	}
 
-	for _, f := range nonNegativeFields {
-		if f.value < 0 {
+	// External labels must be valid Prometheus label names
+	for _, name := range slices.Sorted(maps.Keys(cfg.ExternalLabels)) {
+		if !prometheusLabelNameRegexp.MatchString(name) {
 			allErrs = append(
 				allErrs,
-				field.Invalid(field.NewPath(f.path), f.value, "value cannot be negative"),
+				field.Invalid(fldPath.Child("external_labels").Key(name), name, "invalid label name"),
 			)
 		}
 	}
 
-	// Validate resource references
-	type resourceRef struct {
-		path string
-		ref  *config.ResourceReference
+	if cfg.WAL.BufferSize < 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(fldPath.Child("wal", "buffer_size"), cfg.WAL.BufferSize, "value cannot be negative"),
+		)
+	}
+
+	// Referenced resources from the Prometheus Remote Write exporter
+	allErrs = append(allErrs, validateResourceReferences(fldPath, cfg.Token, cfg.TLS)...)
+
+	return allErrs
+}
+
+// validateKafkaExporter validates the given [config.KafkaExporterConfig].
+func validateKafkaExporter(fldPath *field.Path, cfg config.KafkaExporterConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	// At least one broker is required when the exporter is enabled
+	brokersPath := fldPath.Child("brokers")
+	if cfg.IsEnabled() && len(cfg.Brokers) == 0 {
+		allErrs = append(allErrs, field.Required(brokersPath, "no brokers specified"))
+	}
+
+	seen := make(map[string]bool)
+	for i, broker := range cfg.Brokers {
+		allErrs = append(allErrs, validateHostPort(brokersPath.Index(i), broker)...)
+		if seen[broker] {
+			allErrs = append(allErrs, field.Duplicate(brokersPath.Index(i), broker))
+		}
+		seen[broker] = true
 	}
 
-	resourceRefs := []resourceRef{
+	// Validate the topics
+	topics := []struct {
+		path  *field.Path
+		value string
+	}{
+		{
+			path:  fldPath.Child("topics", "logs"),
+			value: cfg.Topics.Logs,
+		},
 		{
-			path: "spec.exporters.otlp_http.token",
-			ref:  cfg.Spec.Exporters.OTLPHTTPExporter.Token,
+			path:  fldPath.Child("topics", "metrics"),
+			value: cfg.Topics.Metrics,
 		},
 		{
-			path: "spec.exporters.otlp_grpc.token",
-			ref:  cfg.Spec.Exporters.OTLPGRPCExporter.Token,
+			path:  fldPath.Child("topics", "traces"),
+			value: cfg.Topics.Traces,
 		},
 	}
 
-	// Referenced resources from the OTLP HTTP exporter
-	if cfg.Spec.Exporters.OTLPHTTPExporter.TLS != nil {
-		resourceRefs = append(
-			resourceRefs,
-			resourceRef{
-				path: "spec.exporters.otlp_http.tls.ca",
-				ref:  cfg.Spec.Exporters.OTLPHTTPExporter.TLS.CA,
-			},
-			resourceRef{
-				path: "spec.exporters.otlp_http.tls.cert",
-				ref:  cfg.Spec.Exporters.OTLPHTTPExporter.TLS.Cert,
-			},
-			resourceRef{
-				path: "spec.exporters.otlp_http.tls.key",
-				ref:  cfg.Spec.Exporters.OTLPHTTPExporter.TLS.Key,
-			},
-		)
+	for _, f := range topics {
+		switch {
+		case f.value == "":
+			if cfg.IsEnabled() {
+				allErrs = append(allErrs, field.Required(f.path, "topic is empty"))
+			}
+		case len(f.value) > maxKafkaTopicLength:
+			allErrs = append(allErrs, field.TooLong(f.path, f.value, maxKafkaTopicLength))
+		case f.value == "." || f.value == ".." || !kafkaTopicRegexp.MatchString(f.value):
+			allErrs = append(allErrs, field.Invalid(f.path, f.value, "invalid Kafka topic name"))
+		}
 	}
 
-	// Referenced resources from the OTLP gRPC exporter
-	if cfg.Spec.Exporters.OTLPGRPCExporter.TLS != nil {
-		resourceRefs = append(
-			resourceRefs,
-			resourceRef{
-				path: "spec.exporters.otlp_grpc.tls.ca",
-				ref:  cfg.Spec.Exporters.OTLPGRPCExporter.TLS.CA,
-			},
-			resourceRef{
-				path: "spec.exporters.otlp_grpc.tls.cert",
-				ref:  cfg.Spec.Exporters.OTLPGRPCExporter.TLS.Cert,
-			},
-			resourceRef{
-				path: "spec.exporters.otlp_grpc.tls.key",
-				ref:  cfg.Spec.Exporters.OTLPGRPCExporter.TLS.Key,
-			},
-		)
+	if cfg.Encoding != "" {
+		supportedEncodings := []string{
+			string(config.KafkaEncodingOTLPProto),
+			string(config.KafkaEncodingOTLPJSON),
+		}
+		if !slices.Contains(supportedEncodings, string(cfg.Encoding)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("encoding"), cfg.Encoding, supportedEncodings))
+		}
 	}
 
-	for _, f := range resourceRefs {
-		if f.ref != nil {
+	// Referenced resources from the Kafka exporter
+	allErrs = append(allErrs, validateResourceReferences(fldPath, nil, cfg.TLS)...)
+
+	if sasl := cfg.SASL; sasl != nil {
+		saslPath := fldPath.Child("sasl")
+		supportedMechanisms := []string{
+			string(config.KafkaSASLMechanismPlain),
+			string(config.KafkaSASLMechanismSCRAMSHA256),
+			string(config.KafkaSASLMechanismSCRAMSHA512),
+		}
+		if !slices.Contains(supportedMechanisms, string(sasl.Mechanism)) {
+			allErrs = append(allErrs, field.NotSupported(saslPath.Child("mechanism"), sasl.Mechanism, supportedMechanisms))
+		}
+
+		for _, f := range kafkaSASLResourceReferences(saslPath, sasl) {
+			if f.ref == nil {
+				allErrs = append(allErrs, field.Required(f.path, "no resource reference specified"))
+				continue
+			}
 			if f.ref.ResourceRef.Name == "" || f.ref.ResourceRef.DataKey == "" {
 				allErrs = append(
 					allErrs,
-					field.Invalid(field.NewPath(f.path), f.path, "name or dataKey is empty"),
+					field.Invalid(f.path, f.path.String(), "name or dataKey is empty"),
 				)
 			}
 		}
 	}
 
-	// Validate expected string values are not empty
-	type nonEmptyString struct {
-		path  string
-		value string
+	return allErrs
+}
+
+// validateHostPort validates that the given address is in the host:port
+// format.
+func validateHostPort(fldPath *field.Path, address string) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, address, err.Error()))
 	}
 
-	nonEmptyStrings := make([]nonEmptyString, 0)
-	if cfg.Spec.Exporters.OTLPGRPCExporter.IsEnabled() {
-		nonEmptyStrings = append(
-			nonEmptyStrings,
-			nonEmptyString{
-				path:  "spec.exporters.otlp_grpc.endpoint",
-				value: cfg.Spec.Exporters.OTLPGRPCExporter.Endpoint,
-			},
-		)
+	if host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, address, "empty host specified"))
+	}
+
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath, address, "invalid port specified"))
+	}
+
+	return allErrs
+}
+
+// kafkaSASLResourceReferences returns the resources referenced by the SASL
+// settings of the Kafka exporter.
+func kafkaSASLResourceReferences(fldPath *field.Path, sasl *config.KafkaSASLConfig) []resourceRef {
+	return []resourceRef{
+		{
+			path: fldPath.Child("username"),
+			ref:  sasl.Username,
+		},
+		{
+			path: fldPath.Child("password"),
+			ref:  sasl.Password,
+		},
+	}
+}
+
+// validateSendingQueue validates the given [config.SendingQueueConfig].
+func validateSendingQueue(fldPath *field.Path, cfg config.SendingQueueConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	// The sending queue settings are rendered only when explicitly
+	// configured, otherwise the defaults of the collector apply.
+	if cfg.Enabled == nil || !*cfg.Enabled {
+		return allErrs
+	}
+
+	positiveFields := []struct {
+		path  *field.Path
+		value int
+	}{
+		{
+			path:  fldPath.Child("num_consumers"),
+			value: cfg.NumConsumers,
+		},
+		{
+			path:  fldPath.Child("queue_size"),
+			value: cfg.QueueSize,
+		},
 	}
 
-	for _, f := range nonEmptyStrings {
-		if f.value == "" {
+	for _, f := range positiveFields {
+		if f.value <= 0 {
 			allErrs = append(
 				allErrs,
-				field.Invalid(field.NewPath(f.path), f.path, "empty value specified"),
+				field.Invalid(f.path, f.value, "value must be positive"),
 			)
 		}
 	}
 
-	return allErrs.ToAggregate()
+	supportedStorages := []string{
+		string(config.SendingQueueStorageMemory),
+		string(config.SendingQueueStorageFile),
+	}
+	if cfg.Storage != "" && !slices.Contains(supportedStorages, string(cfg.Storage)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("storage"), cfg.Storage, supportedStorages))
+	}
+
+	return allErrs
+}
+
+// validateResourceAttributes validates the settings for the resource
+// attributes. Static attributes may not override the attributes, which are
+// derived from the shoot.
+func validateResourceAttributes(fldPath *field.Path, cfg config.ResourceAttributesConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	for _, key := range slices.Sorted(maps.Keys(cfg.Static)) {
+		keyPath := fldPath.Child("static").Key(key)
+		switch {
+		case strings.TrimSpace(key) == "":
+			allErrs = append(allErrs, field.Required(keyPath, "key is empty"))
+		case key == "k8s.cluster.name" || strings.HasPrefix(key, "gardener."):
+			allErrs = append(allErrs, field.Forbidden(keyPath, "key is reserved for the attributes derived from the shoot"))
+		}
+	}
+
+	metadataKeys := []struct {
+		path *field.Path
+		keys []string
+	}{
+		{path: fldPath.Child("shoot_labels"), keys: cfg.ShootLabels},
+		{path: fldPath.Child("shoot_annotations"), keys: cfg.ShootAnnotations},
+	}
+
+	for _, f := range metadataKeys {
+		seen := make(map[string]bool)
+		for i, key := range f.keys {
+			keyPath := f.path.Index(i)
+			for _, msg := range validation.IsQualifiedName(key) {
+				allErrs = append(allErrs, field.Invalid(keyPath, key, msg))
+			}
+
+			if seen[key] {
+				allErrs = append(allErrs, field.Duplicate(keyPath, key))
+			}
+			seen[key] = true
+		}
+	}
+
+	return allErrs
+}
+
+// validateFilters validates the settings of the filter processors. The
+// conditions are parsed with the OTTL contexts of the respective fields, in
+// the same way as the filter processor does, so that invalid conditions are
+// rejected before the collector fails to start with them.
+func validateFilters(fldPath *field.Path, cfg config.FiltersConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	supportedErrorModes := []config.FilterErrorMode{
+		config.FilterErrorModeIgnore,
+		config.FilterErrorModeSilent,
+		config.FilterErrorModePropagate,
+	}
+	if cfg.ErrorMode != "" && !slices.Contains(supportedErrorModes, cfg.ErrorMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("error_mode"), cfg.ErrorMode, supportedErrorModes))
+	}
+
+	settings := component.TelemetrySettings{Logger: zap.NewNop()}
+	conditions := []struct {
+		path       *field.Path
+		conditions []string
+		parse      ottlConditionParser
+	}{
+		{
+			path:       fldPath.Child("logs", "log_record"),
+			conditions: cfg.Logs.LogRecord,
+			parse:      newOTTLConditionParser(ottllog.NewParser(ottlfuncs.StandardConverters[*ottllog.TransformContext](), settings)),
+		},
+		{
+			path:       fldPath.Child("metrics", "metric"),
+			conditions: cfg.Metrics.Metric,
+			parse:      newOTTLConditionParser(ottlmetric.NewParser(ottlMetricFunctions(), settings)),
+		},
+		{
+			path:       fldPath.Child("metrics", "datapoint"),
+			conditions: cfg.Metrics.DataPoint,
+			parse:      newOTTLConditionParser(ottldatapoint.NewParser(ottlfuncs.StandardConverters[*ottldatapoint.TransformContext](), settings)),
+		},
+		{
+			path:       fldPath.Child("traces", "span"),
+			conditions: cfg.Traces.Span,
+			parse:      newOTTLConditionParser(ottlspan.NewParser(ottlSpanFunctions(), settings)),
+		},
+		{
+			path:       fldPath.Child("traces", "spanevent"),
+			conditions: cfg.Traces.SpanEvent,
+			parse:      newOTTLConditionParser(ottlspanevent.NewParser(ottlfuncs.StandardConverters[*ottlspanevent.TransformContext](), settings)),
+		},
+	}
+
+	for _, f := range conditions {
+		for i, condition := range f.conditions {
+			if err := f.parse(condition); err != nil {
+				allErrs = append(allErrs, field.Invalid(f.path.Index(i), condition, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ottlConditionParser parses an OTTL condition in a specific OTTL context.
+type ottlConditionParser func(condition string) error
+
+// newOTTLConditionParser returns an [ottlConditionParser] for the given parser
+// of an OTTL context and the error, which occurred while creating it.
+func newOTTLConditionParser[K any](parser ottl.Parser[K], err error) ottlConditionParser {
+	return func(condition string) error {
+		if err != nil {
+			return err
+		}
+
+		_, parseErr := parser.ParseCondition(condition)
+
+		return parseErr
+	}
+}
+
+// ottlSpanFunctions returns the OTTL functions, which the filter processor
+// supports in the conditions of the span context.
+func ottlSpanFunctions() map[string]ottl.Factory[*ottlspan.TransformContext] {
+	functions := ottlfuncs.StandardConverters[*ottlspan.TransformContext]()
+	isRootSpan := ottlfuncs.NewIsRootSpanFactoryNew()
+	functions[isRootSpan.Name()] = isRootSpan
+
+	return functions
+}
+
+// ottlMetricFunctions returns the OTTL functions, which the filter processor
+// supports in the conditions of the metric context. Besides the standard
+// converters, the filter processor provides the HasAttrOnDatapoint and
+// HasAttrKeyOnDatapoint functions, which are only parsed here and never
+// evaluated.
+func ottlMetricFunctions() map[string]ottl.Factory[*ottlmetric.TransformContext] {
+	functions := ottlfuncs.StandardConverters[*ottlmetric.TransformContext]()
+
+	type hasAttrOnDatapointArguments struct {
+		Key         string
+		ExpectedVal string
+	}
+	type hasAttrKeyOnDatapointArguments struct {
+		Key string
+	}
+
+	factories := []ottl.Factory[*ottlmetric.TransformContext]{
+		ottl.NewFactory("HasAttrOnDatapoint", &hasAttrOnDatapointArguments{}, newUnevaluatedOTTLFunction[*ottlmetric.TransformContext]),
+		ottl.NewFactory("HasAttrKeyOnDatapoint", &hasAttrKeyOnDatapointArguments{}, newUnevaluatedOTTLFunction[*ottlmetric.TransformContext]),
+	}
+	for _, factory := range factories {
+		functions[factory.Name()] = factory
+	}
+
+	return functions
+}
+
+// newUnevaluatedOTTLFunction creates an OTTL function, which is only used for
+// parsing conditions and fails, when it is evaluated.
+func newUnevaluatedOTTLFunction[K any](_ ottl.FunctionContext, _ ottl.Arguments) (ottl.ExprFunc[K], error) {
+	return func(context.Context, K) (any, error) {
+		return nil, errors.New("function is not evaluated during validation")
+	}, nil
+}
+
+// validateScaling validates the scaling settings of the collector and the
+// Target Allocator.
+func validateScaling(fldPath *field.Path, cfg config.ScalingConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	collectorPath := fldPath.Child("collector")
+	allErrs = append(allErrs, validateReplicas(collectorPath.Child("replicas"), cfg.Collector.Replicas)...)
+	allErrs = append(allErrs, validateResources(collectorPath.Child("resources"), cfg.Collector.Resources)...)
+	allErrs = append(allErrs, validateAutoscaling(collectorPath.Child("autoscaling"), cfg.Collector.Autoscaling)...)
+
+	targetAllocatorPath := fldPath.Child("target_allocator")
+	allErrs = append(allErrs, validateReplicas(targetAllocatorPath.Child("replicas"), cfg.TargetAllocator.Replicas)...)
+	allErrs = append(allErrs, validateResources(targetAllocatorPath.Child("resources"), cfg.TargetAllocator.Resources)...)
+
+	return allErrs
+}
+
+// validateReplicas validates the given number of replicas.
+func validateReplicas(fldPath *field.Path, replicas *int32) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if replicas != nil && *replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *replicas, "value must be at least 1"))
+	}
+
+	return allErrs
+}
+
+// validateResources validates the compute resources of a container. The
+// quantities must not be negative and the limits must not be lower than the
+// requests.
+func validateResources(fldPath *field.Path, resources *corev1.ResourceRequirements) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if resources == nil {
+		return allErrs
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(resources.Requests)) {
+		if quantity := resources.Requests[name]; quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests").Key(string(name)), quantity.String(), "value must not be negative"))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(resources.Limits)) {
+		limit := resources.Limits[name]
+		limitPath := fldPath.Child("limits").Key(string(name))
+		if limit.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(limitPath, limit.String(), "value must not be negative"))
+
+			continue
+		}
+
+		if request, ok := resources.Requests[name]; ok && limit.Cmp(request) < 0 {
+			allErrs = append(allErrs, field.Invalid(limitPath, limit.String(), "value must be greater than or equal to the request"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAutoscaling validates the horizontal pod autoscaler settings of the
+// collector.
+func validateAutoscaling(fldPath *field.Path, cfg *config.AutoscalingConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	minReplicas := int32(1)
+	if cfg.MinReplicas != nil {
+		minReplicas = *cfg.MinReplicas
+		allErrs = append(allErrs, validateReplicas(fldPath.Child("min_replicas"), cfg.MinReplicas)...)
+	}
+
+	if cfg.MaxReplicas < minReplicas {
+		allErrs = append(
+			allErrs,
+			field.Invalid(fldPath.Child("max_replicas"), cfg.MaxReplicas, "value must be greater than or equal to min_replicas"),
+		)
+	}
+
+	utilizations := []struct {
+		name  string
+		value *int32
+	}{
+		{name: "target_cpu_utilization", value: cfg.TargetCPUUtilization},
+		{name: "target_memory_utilization", value: cfg.TargetMemoryUtilization},
+	}
+
+	for _, u := range utilizations {
+		if u.value != nil && (*u.value < 1 || *u.value > 100) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(fldPath.Child(u.name), *u.value, "value must be between 1 and 100"),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+// validateMetricsScrape validates the settings for scraping the metrics of the
+// control-plane components.
+func validateMetricsScrape(fldPath *field.Path, cfg config.MetricsScrapeConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if cfg.Interval < 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(fldPath.Child("interval"), cfg.Interval.String(), "value must not be negative"),
+		)
+	}
+
+	supportedStrategies := []string{
+		string(config.AllocationStrategyConsistentHashing),
+		string(config.AllocationStrategyLeastWeighted),
+	}
+	if cfg.AllocationStrategy != "" && !slices.Contains(supportedStrategies, string(cfg.AllocationStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("allocation_strategy"), cfg.AllocationStrategy, supportedStrategies))
+	}
+
+	selectors := []struct {
+		name     string
+		selector *metav1.LabelSelector
+	}{
+		{name: "service_monitor_selector", selector: cfg.ServiceMonitorSelector},
+		{name: "pod_monitor_selector", selector: cfg.PodMonitorSelector},
+		{name: "scrape_config_selector", selector: cfg.ScrapeConfigSelector},
+	}
+
+	for _, s := range selectors {
+		allErrs = append(
+			allErrs,
+			metav1validation.ValidateLabelSelector(s.selector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child(s.name))...,
+		)
+	}
+
+	for i, relabelConfig := range cfg.MetricRelabelConfigs {
+		allErrs = append(allErrs, validateRelabelConfig(fldPath.Child("metric_relabel_configs").Index(i), relabelConfig)...)
+	}
+
+	return allErrs
+}
+
+// validateRelabelConfig validates a Prometheus relabeling rule by parsing it
+// with the Prometheus relabeling configuration, so that invalid rules are
+// rejected before they reach the Target Allocator. Label names are validated
+// according to the UTF-8 validation scheme, which is the default of
+// Prometheus 3.
+func validateRelabelConfig(fldPath *field.Path, cfg config.RelabelConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	// Fields, which are not specified, are omitted, so that the Prometheus
+	// defaults apply.
+	rule := map[string]any{
+		"action": string(cmp.Or(cfg.Action, config.RelabelActionReplace)),
+	}
+	if len(cfg.SourceLabels) > 0 {
+		rule["source_labels"] = cfg.SourceLabels
+	}
+	if cfg.Separator != nil {
+		rule["separator"] = *cfg.Separator
+	}
+	if cfg.TargetLabel != "" {
+		rule["target_label"] = cfg.TargetLabel
+	}
+	if cfg.Regex != "" {
+		rule["regex"] = cfg.Regex
+	}
+	if cfg.Modulus != 0 {
+		rule["modulus"] = cfg.Modulus
+	}
+	if cfg.Replacement != nil {
+		rule["replacement"] = *cfg.Replacement
+	}
+
+	data, err := yaml.Marshal(rule)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+
+	var relabelConfig relabel.Config
+	if err := yaml.UnmarshalStrict(data, &relabelConfig); err != nil {
+		return append(allErrs, field.Invalid(fldPath, rule, err.Error()))
+	}
+
+	if err := relabelConfig.Validate(model.UTF8Validation); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, rule, err.Error()))
+	}
+
+	return allErrs
+}
+
+// validateKubernetesObjects validates the settings for collecting Kubernetes
+// objects from the shoot cluster.
+func validateKubernetesObjects(fldPath *field.Path, objects []config.KubernetesObjectConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	seen := make(map[schema.GroupResource]bool)
+	for i, object := range objects {
+		objectPath := fldPath.Index(i)
+
+		if object.Name == "" {
+			allErrs = append(allErrs, field.Required(objectPath.Child("name"), "name is empty"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(object.Name) {
+				allErrs = append(allErrs, field.Invalid(objectPath.Child("name"), object.Name, msg))
+			}
+		}
+
+		if object.Group != "" {
+			for _, msg := range validation.IsDNS1123Subdomain(object.Group) {
+				allErrs = append(allErrs, field.Invalid(objectPath.Child("group"), object.Group, msg))
+			}
+		}
+
+		// Namespaces of the same resource are to be specified in a single
+		// entry, so that the objects are not collected multiple times.
+		groupResource := schema.GroupResource{Group: object.Group, Resource: object.Name}
+		if seen[groupResource] {
+			allErrs = append(allErrs, field.Duplicate(objectPath, groupResource.String()))
+		}
+		seen[groupResource] = true
+
+		// Namespaced RBAC does not grant access to cluster-scoped resources.
+		if clusterScopedResources[groupResource] && len(object.Namespaces) > 0 {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(objectPath.Child("namespaces"), "resource "+groupResource.String()+" is cluster-scoped"),
+			)
+		}
+
+		supportedModes := []string{
+			string(config.KubernetesObjectModePull),
+			string(config.KubernetesObjectModeWatch),
+		}
+		if object.Mode != "" && !slices.Contains(supportedModes, string(object.Mode)) {
+			allErrs = append(allErrs, field.NotSupported(objectPath.Child("mode"), object.Mode, supportedModes))
+		}
+
+		if object.Interval < 0 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(objectPath.Child("interval"), object.Interval.String(), "value must not be negative"),
+			)
+		}
+
+		seenNamespaces := make(map[string]bool)
+		for j, namespace := range object.Namespaces {
+			namespacePath := objectPath.Child("namespaces").Index(j)
+			for _, msg := range validation.IsDNS1123Label(namespace) {
+				allErrs = append(allErrs, field.Invalid(namespacePath, namespace, msg))
+			}
+
+			if seenNamespaces[namespace] {
+				allErrs = append(allErrs, field.Duplicate(namespacePath, namespace))
+			}
+			seenNamespaces[namespace] = true
+		}
+
+		if _, err := labels.Parse(object.LabelSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(objectPath.Child("label_selector"), object.LabelSelector, err.Error()))
+		}
+
+		if _, err := fields.ParseSelector(object.FieldSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(objectPath.Child("field_selector"), object.FieldSelector, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateEvents validates the settings for filtering the Kubernetes events.
+// The namespaces of the events are either specified via the events settings or
+// via the Kubernetes objects, but not via both.
+func validateEvents(fldPath *field.Path, cfg config.EventsConfig, objects []config.KubernetesObjectConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	included := make(map[string]bool)
+	for i, namespace := range cfg.Namespaces {
+		namespacePath := fldPath.Child("namespaces").Index(i)
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(namespacePath, namespace, msg))
+		}
+
+		if included[namespace] {
+			allErrs = append(allErrs, field.Duplicate(namespacePath, namespace))
+		}
+		included[namespace] = true
+	}
+
+	excluded := make(map[string]bool)
+	for i, namespace := range cfg.ExcludeNamespaces {
+		namespacePath := fldPath.Child("exclude_namespaces").Index(i)
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(namespacePath, namespace, msg))
+		}
+
+		switch {
+		case excluded[namespace]:
+			allErrs = append(allErrs, field.Duplicate(namespacePath, namespace))
+		case included[namespace]:
+			allErrs = append(allErrs, field.Invalid(namespacePath, namespace, "namespace is included as well"))
+		}
+		excluded[namespace] = true
+	}
+
+	if len(cfg.Namespaces) > 0 {
+		for i, object := range objects {
+			if object.IsEvents() && len(object.Namespaces) > 0 {
+				allErrs = append(
+					allErrs,
+					field.Forbidden(
+						field.NewPath("spec.kubernetes_objects").Index(i).Child("namespaces"),
+						"namespaces of the events are specified via spec.events.namespaces",
+					),
+				)
+			}
+		}
+	}
+
+	supportedTypes := []string{string(config.EventTypeNormal), string(config.EventTypeWarning)}
+	for i, eventType := range cfg.Types {
+		if !slices.Contains(supportedTypes, string(eventType)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("types").Index(i), eventType, supportedTypes))
+		}
+	}
+
+	reasons := []struct {
+		path   *field.Path
+		values []string
+	}{
+		{path: fldPath.Child("reasons"), values: cfg.Reasons},
+		{path: fldPath.Child("exclude_reasons"), values: cfg.ExcludeReasons},
+	}
+
+	for _, r := range reasons {
+		for i, reason := range r.values {
+			if reason == "" {
+				allErrs = append(allErrs, field.Required(r.path.Index(i), "reason is empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateSampling validates the sampling settings of the traces pipeline.
+func validateSampling(fldPath *field.Path, cfg config.SamplingConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if cfg.Probabilistic.IsEnabled() {
+		percentage := cfg.Probabilistic.SamplingPercentage
+		if percentage <= 0 || percentage > 100 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(fldPath.Child("probabilistic", "sampling_percentage"), percentage, "value must be greater than 0 and at most 100"),
+			)
+		}
+	}
+
+	if !cfg.TailSampling.IsEnabled() {
+		return allErrs
+	}
+
+	tailSamplingPath := fldPath.Child("tail_sampling")
+	if cfg.TailSampling.DecisionWait <= 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(tailSamplingPath.Child("decision_wait"), cfg.TailSampling.DecisionWait.String(), "value must be positive"),
+		)
+	}
+
+	if cfg.TailSampling.NumTraces <= 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(tailSamplingPath.Child("num_traces"), cfg.TailSampling.NumTraces, "value must be positive"),
+		)
+	}
+
+	if len(cfg.TailSampling.Policies) == 0 {
+		allErrs = append(allErrs, field.Required(tailSamplingPath.Child("policies"), "no policy specified"))
+	}
+
+	seen := make(map[string]bool)
+	for i, policy := range cfg.TailSampling.Policies {
+		policyPath := tailSamplingPath.Child("policies").Index(i)
+		switch {
+		case policy.Name == "":
+			allErrs = append(allErrs, field.Required(policyPath.Child("name"), "name is empty"))
+		case seen[policy.Name]:
+			allErrs = append(allErrs, field.Duplicate(policyPath.Child("name"), policy.Name))
+		}
+		seen[policy.Name] = true
+
+		allErrs = append(allErrs, validateTailSamplingPolicy(policyPath, policy)...)
+	}
+
+	return allErrs
+}
+
+// validateTailSamplingPolicy validates the type specific settings of a tail
+// sampling policy.
+func validateTailSamplingPolicy(fldPath *field.Path, policy config.TailSamplingPolicy) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	switch policy.Type {
+	case config.TailSamplingPolicyTypeLatency:
+		latencyPath := fldPath.Child("latency")
+		if policy.Latency == nil {
+			return append(allErrs, field.Required(latencyPath, "latency settings are required"))
+		}
+
+		if policy.Latency.ThresholdMs <= 0 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(latencyPath.Child("threshold_ms"), policy.Latency.ThresholdMs, "value must be positive"),
+			)
+		}
+
+		if upper := policy.Latency.UpperThresholdMs; upper != 0 && upper <= policy.Latency.ThresholdMs {
+			allErrs = append(
+				allErrs,
+				field.Invalid(latencyPath.Child("upper_threshold_ms"), upper, "value must be greater than threshold_ms"),
+			)
+		}
+	case config.TailSamplingPolicyTypeStatusCode:
+		statusCodePath := fldPath.Child("status_code")
+		if policy.StatusCode == nil || len(policy.StatusCode.StatusCodes) == 0 {
+			return append(allErrs, field.Required(statusCodePath.Child("status_codes"), "no status code specified"))
+		}
+
+		supportedStatusCodes := []string{"ERROR", "OK", "UNSET"}
+		for i, code := range policy.StatusCode.StatusCodes {
+			if !slices.Contains(supportedStatusCodes, code) {
+				allErrs = append(
+					allErrs,
+					field.NotSupported(statusCodePath.Child("status_codes").Index(i), code, supportedStatusCodes),
+				)
+			}
+		}
+	case config.TailSamplingPolicyTypeStringAttribute:
+		attributePath := fldPath.Child("string_attribute")
+		if policy.StringAttribute == nil {
+			return append(allErrs, field.Required(attributePath, "string attribute settings are required"))
+		}
+
+		if policy.StringAttribute.Key == "" {
+			allErrs = append(allErrs, field.Required(attributePath.Child("key"), "key is empty"))
+		}
+
+		if len(policy.StringAttribute.Values) == 0 {
+			allErrs = append(allErrs, field.Required(attributePath.Child("values"), "no value specified"))
+		}
+
+		if policy.StringAttribute.EnabledRegexMatching {
+			for i, value := range policy.StringAttribute.Values {
+				if _, err := regexp.Compile(value); err != nil {
+					allErrs = append(
+						allErrs,
+						field.Invalid(attributePath.Child("values").Index(i), value, err.Error()),
+					)
+				}
+			}
+		}
+	default:
+		allErrs = append(
+			allErrs,
+			field.NotSupported(
+				fldPath.Child("type"),
+				policy.Type,
+				[]config.TailSamplingPolicyType{
+					config.TailSamplingPolicyTypeLatency,
+					config.TailSamplingPolicyTypeStatusCode,
+					config.TailSamplingPolicyTypeStringAttribute,
+				},
+			),
+		)
+	}
+
+	return allErrs
+}
+
+// validateBufferSizes validates the read and write buffer sizes of an
+// exporter client.
+func validateBufferSizes(fldPath *field.Path, readBufferSize, writeBufferSize int) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	nonNegativeFields := []struct {
+		path  *field.Path
+		value int
+	}{
+		{
+			path:  fldPath.Child("read_buffer_size"),
+			value: readBufferSize,
+		},
+		{
+			path:  fldPath.Child("write_buffer_size"),
+			value: writeBufferSize,
+		},
+	}
+
+	for _, f := range nonNegativeFields {
+		if f.value < 0 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(f.path, f.value, "value cannot be negative"),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+// resourceRef is a reference to a resource along with the path of the field,
+// which specifies it.
+type resourceRef struct {
+	path *field.Path
+	ref  *config.ResourceReference
+}
+
+// exporterResourceReferences returns the resources referenced by an
+// exporter via its bearer token and TLS settings.
+func exporterResourceReferences(fldPath *field.Path, token *config.ResourceReference, tls *config.TLSConfig) []resourceRef {
+	resourceRefs := []resourceRef{
+		{
+			path: fldPath.Child("token"),
+			ref:  token,
+		},
+	}
+
+	if tls != nil {
+		resourceRefs = append(
+			resourceRefs,
+			resourceRef{
+				path: fldPath.Child("tls", "ca"),
+				ref:  tls.CA,
+			},
+			resourceRef{
+				path: fldPath.Child("tls", "cert"),
+				ref:  tls.Cert,
+			},
+			resourceRef{
+				path: fldPath.Child("tls", "key"),
+				ref:  tls.Key,
+			},
+		)
+	}
+
+	return resourceRefs
+}
+
+// ReferencedResource is a resource referenced by an enabled exporter along with
+// the path of the field, which references it.
+type ReferencedResource struct {
+	// Path is the path of the field, which references the resource.
+	Path *field.Path
+
+	// Ref is the reference to the resource.
+	Ref config.ResourceReference
+}
+
+// ReferencedResources returns the resources referenced by the enabled
+// exporters of the given [config.CollectorConfig].
+func ReferencedResources(cfg config.CollectorConfig) []ReferencedResource {
+	exportersPath := field.NewPath("spec.exporters")
+	exporters := cfg.Spec.Exporters
+	resourceRefs := make([]resourceRef, 0)
+
+	if exporters.OTLPHTTPExporter.IsEnabled() {
+		resourceRefs = append(resourceRefs, exporterResourceReferences(exportersPath.Child("otlp_http"), exporters.OTLPHTTPExporter.Token, exporters.OTLPHTTPExporter.TLS)...)
+	}
+
+	if exporters.OTLPGRPCExporter.IsEnabled() {
+		resourceRefs = append(resourceRefs, exporterResourceReferences(exportersPath.Child("otlp_grpc"), exporters.OTLPGRPCExporter.Token, exporters.OTLPGRPCExporter.TLS)...)
+	}
+
+	if prw := exporters.PrometheusRemoteWriteExporter; prw.IsEnabled() {
+		resourceRefs = append(resourceRefs, exporterResourceReferences(exportersPath.Child("prometheusremotewrite"), prw.Token, prw.TLS)...)
+	}
+
+	if kafka := exporters.KafkaExporter; kafka.IsEnabled() {
+		kafkaPath := exportersPath.Child("kafka")
+		resourceRefs = append(resourceRefs, exporterResourceReferences(kafkaPath, nil, kafka.TLS)...)
+		if kafka.SASL != nil {
+			resourceRefs = append(resourceRefs, kafkaSASLResourceReferences(kafkaPath.Child("sasl"), kafka.SASL)...)
+		}
+	}
+
+	for i, exporter := range exporters.NamedOTLPHTTPExporters {
+		if exporter.IsEnabled() {
+			resourceRefs = append(resourceRefs, exporterResourceReferences(exportersPath.Child("named_otlp_http").Index(i), exporter.Token, exporter.TLS)...)
+		}
+	}
+
+	for i, exporter := range exporters.NamedOTLPGRPCExporters {
+		if exporter.IsEnabled() {
+			resourceRefs = append(resourceRefs, exporterResourceReferences(exportersPath.Child("named_otlp_grpc").Index(i), exporter.Token, exporter.TLS)...)
+		}
+	}
+
+	result := make([]ReferencedResource, 0)
+	for _, f := range resourceRefs {
+		if f.ref != nil {
+			result = append(result, ReferencedResource{Path: f.path, Ref: *f.ref})
+		}
+	}
+
+	return result
+}
+
+// validateResourceReferences validates the resources referenced by an
+// exporter.
+func validateResourceReferences(fldPath *field.Path, token *config.ResourceReference, tls *config.TLSConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	for _, f := range exporterResourceReferences(fldPath, token, tls) {
+		if f.ref != nil {
+			if f.ref.ResourceRef.Name == "" || f.ref.ResourceRef.DataKey == "" {
+				allErrs = append(
+					allErrs,
+					field.Invalid(f.path, f.path.String(), "name or dataKey is empty"),
+				)
+			}
+		}
+	}
+
+	return allErrs
 }
@@ -3,3 +3,790 @@
 // SPDX-License-Identifier: Apache-2.0
 
 package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/validation"
+)
+
+var _ = Describe("Validate", func() {
+	var cfg config.CollectorConfig
+
+	BeforeEach(func() {
+		cfg = config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					DebugExporter: config.DebugExporterConfig{
+						Enabled: new(true),
+					},
+				},
+			},
+		}
+	})
+
+	It("should succeed with the default pipelines", func() {
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail when no exporter is enabled", func() {
+		cfg.Spec.Exporters.DebugExporter.Enabled = new(false)
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("no exporter enabled")))
+	})
+
+	It("should succeed when only the traces pipeline is enabled", func() {
+		cfg.Spec.Pipelines = config.CollectorPipelinesConfig{
+			Logs:    config.PipelineConfig{Enabled: new(false)},
+			Events:  config.PipelineConfig{Enabled: new(false)},
+			Metrics: config.PipelineConfig{Enabled: new(false)},
+			Traces:  config.TracesPipelineConfig{Enabled: new(true)},
+		}
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail when all pipelines are disabled", func() {
+		cfg.Spec.Pipelines = config.CollectorPipelinesConfig{
+			Logs:    config.PipelineConfig{Enabled: new(false)},
+			Events:  config.PipelineConfig{Enabled: new(false)},
+			Metrics: config.PipelineConfig{Enabled: new(false)},
+		}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("no pipeline enabled")))
+	})
+
+	It("should succeed when the pipelines reference enabled exporters", func() {
+		cfg.Spec.Exporters.OTLPGRPCExporter = config.OTLPGRPCExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "otlp.example.org:4317",
+		}
+		cfg.Spec.Pipelines.Logs.Exporters = []string{config.ExporterNameDebug}
+		cfg.Spec.Pipelines.Metrics.Exporters = []string{config.ExporterNameOTLPGRPC, config.ExporterNameDebug}
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail when a pipeline references a disabled exporter", func() {
+		cfg.Spec.Pipelines.Metrics.Exporters = []string{config.ExporterNameOTLPGRPC}
+		Expect(validation.Validate(cfg)).To(MatchError(And(
+			ContainSubstring("spec.pipelines.metrics.exporters[0]"),
+			ContainSubstring("exporter is not enabled"),
+		)))
+	})
+
+	It("should fail when a pipeline references an unknown exporter", func() {
+		cfg.Spec.Pipelines.Traces.Exporters = []string{"zipkin"}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.traces.exporters[0]")))
+	})
+
+	It("should fail when a pipeline references an exporter twice", func() {
+		cfg.Spec.Pipelines.Logs.Exporters = []string{config.ExporterNameDebug, config.ExporterNameDebug}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("Duplicate value")))
+	})
+
+	It("should succeed when a pipeline references a named exporter", func() {
+		cfg.Spec.Exporters.NamedOTLPGRPCExporters = []config.NamedOTLPGRPCExporterConfig{
+			{
+				Name: "tenant-a",
+				OTLPGRPCExporterConfig: config.OTLPGRPCExporterConfig{
+					Enabled:  new(true),
+					Endpoint: "tenant-a.example.org:4317",
+				},
+			},
+		}
+		cfg.Spec.Pipelines.Logs.Exporters = []string{"otlp_grpc/tenant-a"}
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should succeed when only a named exporter is enabled", func() {
+		cfg.Spec.Exporters.DebugExporter.Enabled = new(false)
+		cfg.Spec.Exporters.NamedOTLPHTTPExporters = []config.NamedOTLPHTTPExporterConfig{
+			{
+				Name: "backup",
+				OTLPHTTPExporterConfig: config.OTLPHTTPExporterConfig{
+					Enabled:  new(true),
+					Endpoint: "https://backup.example.org:4318",
+				},
+			},
+		}
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail when named exporters have duplicate names", func() {
+		cfg.Spec.Exporters.NamedOTLPHTTPExporters = []config.NamedOTLPHTTPExporterConfig{
+			{Name: "backup"},
+			{Name: "backup"},
+		}
+		Expect(validation.Validate(cfg)).To(MatchError(And(
+			ContainSubstring("spec.exporters.named_otlp_http[1].name"),
+			ContainSubstring("Duplicate value"),
+		)))
+	})
+
+	It("should fail when a named exporter has an invalid name", func() {
+		cfg.Spec.Exporters.NamedOTLPHTTPExporters = []config.NamedOTLPHTTPExporterConfig{
+			{Name: "Not_Valid"},
+		}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named_otlp_http[0].name")))
+	})
+
+	It("should fail when a named exporter has a too long name", func() {
+		cfg.Spec.Exporters.NamedOTLPHTTPExporters = []config.NamedOTLPHTTPExporterConfig{
+			{Name: "a-very-long-exporter-name-1"},
+		}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("may not be more than 24")))
+	})
+
+	It("should validate the settings of the named exporters", func() {
+		cfg.Spec.Exporters.NamedOTLPGRPCExporters = []config.NamedOTLPGRPCExporterConfig{
+			{
+				Name:                   "tenant-a",
+				OTLPGRPCExporterConfig: config.OTLPGRPCExporterConfig{Enabled: new(true)},
+			},
+		}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named_otlp_grpc[0].endpoint")))
+	})
+
+	Context("prometheusremotewrite exporter", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.PrometheusRemoteWriteExporter = config.PrometheusRemoteWriteExporterConfig{
+				Enabled:        new(true),
+				Endpoint:       "https://thanos.example.org/api/v1/receive",
+				ExternalLabels: map[string]string{"cluster": "my-shoot"},
+			}
+		})
+
+		It("should succeed when referenced by the metrics pipeline", func() {
+			cfg.Spec.Pipelines.Metrics.Exporters = []string{config.ExporterNamePrometheusRemoteWrite}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail when referenced by a non-metrics pipeline", func() {
+			cfg.Spec.Pipelines.Logs.Exporters = []string{config.ExporterNamePrometheusRemoteWrite}
+			Expect(validation.Validate(cfg)).To(MatchError(And(
+				ContainSubstring("spec.pipelines.logs.exporters[0]"),
+				ContainSubstring("exporter does not support the logs signal"),
+			)))
+		})
+
+		It("should fail when no other exporter supports the logs signal", func() {
+			cfg.Spec.Exporters.DebugExporter.Enabled = new(false)
+			cfg.Spec.Pipelines.Events.Enabled = new(false)
+			Expect(validation.Validate(cfg)).To(MatchError(And(
+				ContainSubstring("spec.pipelines.logs.exporters"),
+				ContainSubstring("no enabled exporter supports the logs signal"),
+			)))
+		})
+
+		It("should fail without an endpoint", func() {
+			cfg.Spec.Exporters.PrometheusRemoteWriteExporter.Endpoint = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.prometheusremotewrite.endpoint")))
+		})
+
+		It("should fail with an invalid external label name", func() {
+			cfg.Spec.Exporters.PrometheusRemoteWriteExporter.ExternalLabels = map[string]string{"my-label": "value"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.prometheusremotewrite.external_labels[my-label]")))
+		})
+	})
+
+	Context("kafka exporter", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.KafkaExporter = config.KafkaExporterConfig{
+				Enabled:  new(true),
+				Brokers:  []string{"kafka-0.example.org:9093", "kafka-1.example.org:9093"},
+				Encoding: config.KafkaEncodingOTLPProto,
+				Topics: config.KafkaTopicsConfig{
+					Logs:    "otlp_logs",
+					Metrics: "otlp_metrics",
+					Traces:  "otlp_spans",
+				},
+			}
+		})
+
+		It("should succeed with valid settings", func() {
+			cfg.Spec.Pipelines.Logs.Exporters = []string{config.ExporterNameKafka}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without brokers", func() {
+			cfg.Spec.Exporters.KafkaExporter.Brokers = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.kafka.brokers: Required value")))
+		})
+
+		It("should fail with invalid broker addresses", func() {
+			cfg.Spec.Exporters.KafkaExporter.Brokers = []string{"kafka.example.org", "kafka.example.org:99999"}
+			Expect(validation.Validate(cfg)).To(MatchError(And(
+				ContainSubstring("spec.exporters.kafka.brokers[0]"),
+				ContainSubstring("spec.exporters.kafka.brokers[1]"),
+			)))
+		})
+
+		It("should fail with an invalid topic", func() {
+			cfg.Spec.Exporters.KafkaExporter.Topics.Metrics = "otlp metrics"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.kafka.topics.metrics")))
+		})
+
+		It("should fail with incomplete SASL settings", func() {
+			cfg.Spec.Exporters.KafkaExporter.SASL = &config.KafkaSASLConfig{
+				Mechanism: config.KafkaSASLMechanismPlain,
+				Username: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "kafka", DataKey: "username"},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.kafka.sasl.password")))
+		})
+	})
+
+	Context("sending queue", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org:4318",
+				SendingQueue: config.SendingQueueConfig{
+					Enabled:      new(true),
+					NumConsumers: 10,
+					QueueSize:    1000,
+					Storage:      config.SendingQueueStorageFile,
+				},
+			}
+		})
+
+		It("should succeed with a persistent queue", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a non-positive queue size", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.SendingQueue.QueueSize = 0
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_http.sending_queue.queue_size")))
+		})
+
+		It("should fail with an unsupported storage", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Storage = "redis"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_http.sending_queue.storage")))
+		})
+
+		It("should fail with a non-positive storage size", func() {
+			cfg.Spec.Storage.Size = new(resource.MustParse("0"))
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.storage.size")))
+		})
+	})
+
+	Context("kubernetes objects", func() {
+		BeforeEach(func() {
+			cfg.Spec.KubernetesObjects = []config.KubernetesObjectConfig{
+				{
+					Name: "events",
+					Mode: config.KubernetesObjectModeWatch,
+				},
+				{
+					Name:          "pods",
+					Mode:          config.KubernetesObjectModePull,
+					Interval:      10 * time.Minute,
+					Namespaces:    []string{"kube-system"},
+					LabelSelector: "gardener.cloud/role=system-component",
+					FieldSelector: "status.phase!=Running",
+				},
+				{
+					Name:  "leases",
+					Group: "coordination.k8s.io",
+					Mode:  config.KubernetesObjectModeWatch,
+				},
+			}
+		})
+
+		It("should succeed with valid objects", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without a name", func() {
+			cfg.Spec.KubernetesObjects[0].Name = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.kubernetes_objects[0].name")))
+		})
+
+		It("should fail with an invalid group", func() {
+			cfg.Spec.KubernetesObjects[2].Group = "Coordination"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.kubernetes_objects[2].group")))
+		})
+
+		It("should fail with a duplicate resource", func() {
+			cfg.Spec.KubernetesObjects[1].Name = "events"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.kubernetes_objects[1]: Duplicate value")))
+		})
+
+		It("should fail with an unsupported mode", func() {
+			cfg.Spec.KubernetesObjects[0].Mode = "stream"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.kubernetes_objects[0].mode")))
+		})
+
+		It("should fail with a negative interval", func() {
+			cfg.Spec.KubernetesObjects[1].Interval = -time.Minute
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.kubernetes_objects[1].interval")))
+		})
+
+		It("should fail with namespaces for a cluster-scoped resource", func() {
+			cfg.Spec.KubernetesObjects = append(cfg.Spec.KubernetesObjects, config.KubernetesObjectConfig{
+				Name:       "nodes",
+				Mode:       config.KubernetesObjectModePull,
+				Namespaces: []string{"kube-system"},
+			})
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.kubernetes_objects[3].namespaces")))
+		})
+
+		It("should fail with an invalid namespace", func() {
+			cfg.Spec.KubernetesObjects[1].Namespaces = []string{"Kube_System"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.kubernetes_objects[1].namespaces[0]")))
+		})
+
+		It("should fail with invalid selectors", func() {
+			cfg.Spec.KubernetesObjects[1].LabelSelector = "role in (a"
+			cfg.Spec.KubernetesObjects[1].FieldSelector = "status.phase"
+			Expect(validation.Validate(cfg)).To(MatchError(And(
+				ContainSubstring("spec.kubernetes_objects[1].label_selector"),
+				ContainSubstring("spec.kubernetes_objects[1].field_selector"),
+			)))
+		})
+	})
+
+	Context("events", func() {
+		BeforeEach(func() {
+			cfg.Spec.Events = config.EventsConfig{
+				Namespaces:        []string{"kube-system", "default"},
+				ExcludeNamespaces: []string{"garden"},
+				Types:             []config.EventType{config.EventTypeWarning},
+				Reasons:           []string{"FailedScheduling", "BackOff"},
+				ExcludeReasons:    []string{"Pulled"},
+				OnlyNewEvents:     new(true),
+			}
+		})
+
+		It("should succeed with valid events settings", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid namespace", func() {
+			cfg.Spec.Events.Namespaces = []string{"Kube_System"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.events.namespaces[0]")))
+		})
+
+		It("should fail with a namespace, which is included and excluded", func() {
+			cfg.Spec.Events.ExcludeNamespaces = []string{"default"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.events.exclude_namespaces[0]")))
+		})
+
+		It("should fail with namespaces specified for the events objects as well", func() {
+			cfg.Spec.KubernetesObjects = []config.KubernetesObjectConfig{{
+				Name:       "events",
+				Mode:       config.KubernetesObjectModeWatch,
+				Namespaces: []string{"kube-system"},
+			}}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.kubernetes_objects[0].namespaces")))
+		})
+
+		It("should fail with an unsupported type", func() {
+			cfg.Spec.Events.Types = []config.EventType{"Error"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.events.types[0]")))
+		})
+
+		It("should fail with an empty reason", func() {
+			cfg.Spec.Events.ExcludeReasons = []string{""}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.events.exclude_reasons[0]")))
+		})
+	})
+
+	Context("scaling", func() {
+		BeforeEach(func() {
+			cfg.Spec.Scaling = config.ScalingConfig{
+				Collector: config.CollectorScalingConfig{
+					Replicas: new(int32(2)),
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+					Autoscaling: &config.AutoscalingConfig{
+						MinReplicas:          new(int32(2)),
+						MaxReplicas:          5,
+						TargetCPUUtilization: new(int32(75)),
+					},
+				},
+				TargetAllocator: config.TargetAllocatorScalingConfig{
+					Replicas: new(int32(2)),
+				},
+			}
+		})
+
+		It("should succeed with valid scaling settings", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with zero replicas", func() {
+			cfg.Spec.Scaling.TargetAllocator.Replicas = new(int32(0))
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.scaling.target_allocator.replicas")))
+		})
+
+		It("should fail with limits lower than the requests", func() {
+			cfg.Spec.Scaling.Collector.Resources.Limits[corev1.ResourceCPU] = resource.MustParse("50m")
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.scaling.collector.resources.limits[cpu]")))
+		})
+
+		It("should fail with max replicas lower than min replicas", func() {
+			cfg.Spec.Scaling.Collector.Autoscaling.MaxReplicas = 1
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.scaling.collector.autoscaling.max_replicas")))
+		})
+
+		It("should fail with an invalid utilization target", func() {
+			cfg.Spec.Scaling.Collector.Autoscaling.TargetMemoryUtilization = new(int32(120))
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.scaling.collector.autoscaling.target_memory_utilization")))
+		})
+	})
+
+	Context("metrics scrape", func() {
+		BeforeEach(func() {
+			cfg.Spec.Metrics.Scrape = config.MetricsScrapeConfig{
+				Interval:           time.Minute,
+				AllocationStrategy: config.AllocationStrategyLeastWeighted,
+				ServiceMonitorSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "prometheus",
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{"shoot", "cache"},
+					}},
+				},
+				PodMonitorSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"prometheus": "aggregate"},
+				},
+			}
+		})
+
+		It("should succeed with valid scrape settings", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a negative interval", func() {
+			cfg.Spec.Metrics.Scrape.Interval = -time.Second
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.scrape.interval")))
+		})
+
+		It("should fail with an unsupported allocation strategy", func() {
+			cfg.Spec.Metrics.Scrape.AllocationStrategy = "per-node"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.scrape.allocation_strategy")))
+		})
+
+		It("should fail with an invalid label selector", func() {
+			cfg.Spec.Metrics.Scrape.ScrapeConfigSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "prometheus",
+					Operator: metav1.LabelSelectorOpIn,
+				}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.scrape.scrape_config_selector.matchExpressions[0].values")))
+		})
+
+		Context("metric relabel configs", func() {
+			BeforeEach(func() {
+				cfg.Spec.Metrics.Scrape.MetricRelabelConfigs = []config.RelabelConfig{
+					{
+						SourceLabels: []string{"__name__"},
+						Regex:        ".+_bucket",
+						Action:       config.RelabelActionDrop,
+					},
+					{
+						SourceLabels: []string{"pod"},
+						TargetLabel:  "k8s_pod_name",
+						Replacement:  new("${1}"),
+						Action:       config.RelabelActionReplace,
+					},
+					{
+						Regex:  "pod",
+						Action: config.RelabelActionLabelDrop,
+					},
+				}
+			})
+
+			It("should succeed with valid relabel configs", func() {
+				Expect(validation.Validate(cfg)).To(Succeed())
+			})
+
+			It("should fail with an invalid regex", func() {
+				cfg.Spec.Metrics.Scrape.MetricRelabelConfigs[0].Regex = "(.+_bucket"
+				Expect(validation.Validate(cfg)).To(MatchError(And(
+					ContainSubstring("spec.metrics.scrape.metric_relabel_configs[0]"),
+					ContainSubstring("missing closing )"),
+				)))
+			})
+
+			It("should fail with an invalid source label", func() {
+				cfg.Spec.Metrics.Scrape.MetricRelabelConfigs[0].SourceLabels = []string{""}
+				Expect(validation.Validate(cfg)).To(MatchError(And(
+					ContainSubstring("spec.metrics.scrape.metric_relabel_configs[0]"),
+					ContainSubstring(`"" is not a valid label name`),
+				)))
+			})
+
+			It("should fail with an unsupported action", func() {
+				cfg.Spec.Metrics.Scrape.MetricRelabelConfigs[0].Action = "delete"
+				Expect(validation.Validate(cfg)).To(MatchError(And(
+					ContainSubstring("spec.metrics.scrape.metric_relabel_configs[0]"),
+					ContainSubstring(`unknown relabel action "delete"`),
+				)))
+			})
+
+			It("should fail without a target label for the replace action", func() {
+				cfg.Spec.Metrics.Scrape.MetricRelabelConfigs[1].TargetLabel = ""
+				Expect(validation.Validate(cfg)).To(MatchError(And(
+					ContainSubstring("spec.metrics.scrape.metric_relabel_configs[1]"),
+					ContainSubstring("requires 'target_label' value"),
+				)))
+			})
+
+			It("should fail without a modulus for the hashmod action", func() {
+				cfg.Spec.Metrics.Scrape.MetricRelabelConfigs[1].Action = config.RelabelActionHashMod
+				cfg.Spec.Metrics.Scrape.MetricRelabelConfigs[1].Replacement = nil
+				Expect(validation.Validate(cfg)).To(MatchError(And(
+					ContainSubstring("spec.metrics.scrape.metric_relabel_configs[1]"),
+					ContainSubstring("requires non-zero modulus"),
+				)))
+			})
+
+			It("should fail with source labels for the labeldrop action", func() {
+				cfg.Spec.Metrics.Scrape.MetricRelabelConfigs[2].SourceLabels = []string{"pod"}
+				Expect(validation.Validate(cfg)).To(MatchError(And(
+					ContainSubstring("spec.metrics.scrape.metric_relabel_configs[2]"),
+					ContainSubstring("labeldrop action requires only 'regex'"),
+				)))
+			})
+		})
+	})
+
+	Context("sampling", func() {
+		BeforeEach(func() {
+			cfg.Spec.Sampling = config.SamplingConfig{
+				Probabilistic: config.ProbabilisticSamplerConfig{
+					Enabled:            new(true),
+					SamplingPercentage: 25,
+				},
+				TailSampling: config.TailSamplingConfig{
+					Enabled:      new(true),
+					DecisionWait: 30 * time.Second,
+					NumTraces:    50000,
+					Policies: []config.TailSamplingPolicy{
+						{
+							Name:    "slow-traces",
+							Type:    config.TailSamplingPolicyTypeLatency,
+							Latency: &config.LatencyPolicyConfig{ThresholdMs: 500},
+						},
+						{
+							Name:       "errors",
+							Type:       config.TailSamplingPolicyTypeStatusCode,
+							StatusCode: &config.StatusCodePolicyConfig{StatusCodes: []string{"ERROR"}},
+						},
+						{
+							Name: "checkout",
+							Type: config.TailSamplingPolicyTypeStringAttribute,
+							StringAttribute: &config.StringAttributePolicyConfig{
+								Key:    "service.name",
+								Values: []string{"checkout"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should succeed with valid settings", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid sampling percentage", func() {
+			cfg.Spec.Sampling.Probabilistic.SamplingPercentage = 150
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.sampling.probabilistic.sampling_percentage")))
+		})
+
+		It("should fail with tail sampling and multiple collector replicas", func() {
+			cfg.Spec.Scaling.Collector.Replicas = new(int32(2))
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.sampling.tail_sampling.enabled")))
+		})
+
+		It("should fail with tail sampling and an autoscaler of the collector", func() {
+			cfg.Spec.Scaling.Collector.Autoscaling = &config.AutoscalingConfig{MaxReplicas: 3}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.sampling.tail_sampling.enabled")))
+		})
+
+		It("should fail with duplicate policy names", func() {
+			cfg.Spec.Sampling.TailSampling.Policies[1].Name = "slow-traces"
+			Expect(validation.Validate(cfg)).To(MatchError(And(
+				ContainSubstring("spec.sampling.tail_sampling.policies[1].name"),
+				ContainSubstring("Duplicate value"),
+			)))
+		})
+
+		It("should fail with an empty policy name", func() {
+			cfg.Spec.Sampling.TailSampling.Policies[0].Name = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.sampling.tail_sampling.policies[0].name")))
+		})
+
+		It("should fail with an unsupported status code", func() {
+			cfg.Spec.Sampling.TailSampling.Policies[1].StatusCode.StatusCodes = []string{"FAILED"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.sampling.tail_sampling.policies[1].status_code.status_codes[0]")))
+		})
+
+		It("should fail when the settings for the policy type are missing", func() {
+			cfg.Spec.Sampling.TailSampling.Policies[2].StringAttribute = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.sampling.tail_sampling.policies[2].string_attribute")))
+		})
+
+		It("should not validate disabled samplers", func() {
+			cfg.Spec.Sampling.Probabilistic = config.ProbabilisticSamplerConfig{Enabled: new(false)}
+			cfg.Spec.Sampling.TailSampling = config.TailSamplingConfig{Enabled: new(false)}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+	})
+
+	Context("filters", func() {
+		DescribeTable("should accept valid conditions",
+			func(filters config.FiltersConfig) {
+				cfg.Spec.Filters = filters
+				Expect(validation.Validate(cfg)).To(Succeed())
+			},
+			Entry("log record comparison with enum", config.FiltersConfig{
+				Logs: config.LogsFilterConfig{LogRecord: []string{`severity_number < SEVERITY_NUMBER_INFO`}},
+			}),
+			Entry("log record path with keys", config.FiltersConfig{
+				Logs: config.LogsFilterConfig{LogRecord: []string{`resource.attributes["k8s.namespace.name"] != "kube-system"`}},
+			}),
+			Entry("metric converter", config.FiltersConfig{
+				Metrics: config.MetricsFilterConfig{Metric: []string{`IsMatch(name, "^etcd_.*") and not (type == METRIC_DATA_TYPE_SUM)`}},
+			}),
+			Entry("metric function of the filter processor", config.FiltersConfig{
+				Metrics: config.MetricsFilterConfig{Metric: []string{`HasAttrOnDatapoint("le", "+Inf")`}},
+			}),
+			Entry("datapoint math expression", config.FiltersConfig{
+				Metrics: config.MetricsFilterConfig{DataPoint: []string{`(attributes["count"] + 1) * 2 >= -10`}},
+			}),
+			Entry("span list and map literals", config.FiltersConfig{
+				Traces: config.TracesFilterConfig{Span: []string{`ContainsValue(["a", "b"], name) or attributes == {"key": 1}`}},
+			}),
+			Entry("span function of the filter processor", config.FiltersConfig{
+				Traces: config.TracesFilterConfig{Span: []string{`IsRootSpan()`}},
+			}),
+			Entry("span event boolean literal", config.FiltersConfig{
+				Traces: config.TracesFilterConfig{SpanEvent: []string{`true`}},
+			}),
+		)
+
+		DescribeTable("should reject invalid conditions",
+			func(condition, reason string) {
+				cfg.Spec.Filters.Logs.LogRecord = []string{condition}
+				Expect(validation.Validate(cfg)).To(MatchError(And(
+					ContainSubstring("spec.filters.logs.log_record[0]"),
+					ContainSubstring(reason),
+				)))
+			},
+			Entry("empty condition", ` `, "condition has invalid syntax"),
+			Entry("unterminated string", `body == "debug`, "invalid input text"),
+			Entry("unbalanced parenthesis", `(severity_number < 9`, `expected ")"`),
+			Entry("missing operand", `severity_text ==`, "unexpected token \"<EOF>\""),
+			Entry("path only", `attributes["level"]`, "expected <opcomparison> Value"),
+			Entry("editor", `set(attributes["level"], "info")`, "expected <opcomparison> Value"),
+			Entry("assignment", `severity_text = "DEBUG"`, "unexpected token \"=\""),
+			Entry("unknown path", `name == "debug"`, "not a valid path"),
+			Entry("unknown function", `IsRootSpan()`, "undefined function"),
+		)
+
+		It("should validate the conditions in the context of the field", func() {
+			cfg.Spec.Filters.Metrics.Metric = []string{`severity_number < SEVERITY_NUMBER_INFO`}
+			Expect(validation.Validate(cfg)).To(MatchError(And(
+				ContainSubstring("spec.filters.metrics.metric[0]"),
+				ContainSubstring("for the metric context"),
+			)))
+		})
+
+		It("should fail with an unsupported error mode", func() {
+			cfg.Spec.Filters.ErrorMode = "panic"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.filters.error_mode")))
+		})
+	})
+
+	Context("resource attributes", func() {
+		BeforeEach(func() {
+			cfg.Spec.ResourceAttributes = config.ResourceAttributesConfig{
+				Static:           map[string]string{"deployment.environment.name": "production"},
+				ShootLabels:      []string{"shoot.gardener.cloud/status", "team"},
+				ShootAnnotations: []string{"example.org/cost-center"},
+			}
+		})
+
+		It("should succeed with valid settings", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail when a static attribute overrides a derived attribute", func() {
+			cfg.Spec.ResourceAttributes.Static["gardener.shoot.name"] = "other"
+			Expect(validation.Validate(cfg)).To(MatchError(And(
+				ContainSubstring("spec.resource_attributes.static[gardener.shoot.name]"),
+				ContainSubstring("key is reserved"),
+			)))
+		})
+
+		It("should fail with an invalid label key", func() {
+			cfg.Spec.ResourceAttributes.ShootLabels = []string{"not a label"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.resource_attributes.shoot_labels[0]")))
+		})
+
+		It("should fail with duplicate annotation keys", func() {
+			cfg.Spec.ResourceAttributes.ShootAnnotations = []string{"team", "team"}
+			Expect(validation.Validate(cfg)).To(MatchError(And(
+				ContainSubstring("spec.resource_attributes.shoot_annotations[1]"),
+				ContainSubstring("Duplicate value"),
+			)))
+		})
+	})
+})
+
+var _ = Describe("ReferencedResources", func() {
+	ref := func(name, key string) *config.ResourceReference {
+		return &config.ResourceReference{
+			ResourceRef: config.ResourceReferenceDetails{Name: name, DataKey: key},
+		}
+	}
+
+	It("should return the resources referenced by the enabled exporters", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					OTLPHTTPExporter: config.OTLPHTTPExporterConfig{
+						Enabled: new(true),
+						Token:   ref("token", "token"),
+						TLS:     &config.TLSConfig{CA: ref("tls", "ca.crt")},
+					},
+					OTLPGRPCExporter: config.OTLPGRPCExporterConfig{
+						Enabled: new(false),
+						Token:   ref("disabled", "token"),
+					},
+					NamedOTLPGRPCExporters: []config.NamedOTLPGRPCExporterConfig{
+						{
+							Name: "tenant",
+							OTLPGRPCExporterConfig: config.OTLPGRPCExporterConfig{
+								Enabled: new(true),
+								Token:   ref("tenant", "token"),
+							},
+						},
+					},
+				},
+			},
+		}
+
+		paths := make([]string, 0)
+		for _, r := range validation.ReferencedResources(cfg) {
+			paths = append(paths, r.Path.String()+"="+r.Ref.ResourceRef.Name)
+		}
+		Expect(paths).To(Equal([]string{
+			"spec.exporters.otlp_http.token=token",
+			"spec.exporters.otlp_http.tls.ca=tls",
+			"spec.exporters.named_otlp_grpc[0].token=tenant",
+		}))
+	})
+})
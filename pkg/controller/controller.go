@@ -10,15 +10,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/extension"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	crctrl "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // ErrInvalidController is an error, which is returned when attempting to create
@@ -114,6 +121,19 @@ func (c *Controller) SetupWithManager(ctx context.Context, mgr manager.Manager)
 		c.predicates = extension.DefaultPredicates(ctx, mgr, c.ignoreOperationAnnotation)
 	}
 
+	// Reconcile the extensions, when any of the referenced secrets in their
+	// namespace changes, so that rotated credentials are rolled out.
+	c.watchBuilder.Register(func(ctrl crctrl.Controller) error {
+		return ctrl.Watch(
+			source.Kind[client.Object](
+				mgr.GetCache(),
+				&corev1.Secret{},
+				handler.EnqueueRequestsFromMapFunc(ReferencedSecretToExtensionsMapper(mgr.GetClient(), c.extensionType)),
+				ReferencedSecretPredicate(),
+			),
+		)
+	})
+
 	return extension.Add(
 		mgr,
 		extension.AddArgs{
@@ -277,3 +297,38 @@ func WithResyncInterval(duration time.Duration) Option {
 
 	return opt
 }
+
+// ReferencedSecretPredicate returns a [predicate.Predicate], which matches the
+// referenced secrets, i.e. the secrets, which gardenlet copies from the
+// resources of the shoot into the shoot namespaces with the `ref-` prefix.
+func ReferencedSecretPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return strings.HasPrefix(obj.GetNamespace(), v1beta1constants.TechnicalIDPrefix) &&
+			strings.HasPrefix(obj.GetName(), v1beta1constants.ReferencedResourcesPrefix)
+	})
+}
+
+// ReferencedSecretToExtensionsMapper returns a [handler.MapFunc], which maps a
+// referenced secret to the [extensionsv1alpha1.Extension] resources of the
+// given type in the namespace of the secret.
+func ReferencedSecretToExtensionsMapper(reader client.Reader, extensionType string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		extensions := &extensionsv1alpha1.ExtensionList{}
+		if err := reader.List(ctx, extensions, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0)
+		for _, ex := range extensions.Items {
+			if ex.Spec.Type != extensionType {
+				continue
+			}
+
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(&ex),
+			})
+		}
+
+		return requests
+	}
+}
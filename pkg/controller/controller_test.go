@@ -10,12 +10,19 @@ import (
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	crctrl "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
 	"github.com/gardener/gardener-extension-otelcol/pkg/controller"
@@ -108,3 +115,47 @@ var _ = Describe("Controller", Ordered, func() {
 		Expect(c.SetupWithManager(context.TODO(), m)).To(Succeed())
 	})
 })
+
+var _ = Describe("Referenced secrets", func() {
+	const namespace = "shoot--my-project--my-shoot"
+
+	newSecret := func(namespace, name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		}
+	}
+
+	DescribeTable("should match the referenced secrets in shoot namespaces",
+		func(secret *corev1.Secret, want bool) {
+			Expect(controller.ReferencedSecretPredicate().Create(event.CreateEvent{Object: secret})).To(Equal(want))
+		},
+		Entry("referenced secret", newSecret(namespace, "ref-otelcol-token"), true),
+		Entry("other secret", newSecret(namespace, "ca"), false),
+		Entry("referenced secret outside of a shoot namespace", newSecret("garden", "ref-otelcol-token"), false),
+	)
+
+	It("should map a referenced secret to the extensions of the given type", func() {
+		c := fake.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(
+				&v1alpha1.Extension{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "otelcol"},
+					Spec:       v1alpha1.ExtensionSpec{DefaultSpec: v1alpha1.DefaultSpec{Type: "otelcol"}},
+				},
+				&v1alpha1.Extension{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "other"},
+					Spec:       v1alpha1.ExtensionSpec{DefaultSpec: v1alpha1.DefaultSpec{Type: "other"}},
+				},
+				&v1alpha1.Extension{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--my-project--other-shoot", Name: "otelcol"},
+					Spec:       v1alpha1.ExtensionSpec{DefaultSpec: v1alpha1.DefaultSpec{Type: "otelcol"}},
+				},
+			).
+			Build()
+
+		mapper := controller.ReferencedSecretToExtensionsMapper(c, "otelcol")
+		Expect(mapper(context.TODO(), newSecret(namespace, "ref-otelcol-token"))).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "otelcol"}},
+		))
+	})
+})
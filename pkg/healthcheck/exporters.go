@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-logr/logr"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+)
+
+const (
+	// ConditionTypeExportersHealthy is the type of the condition, which
+	// reflects whether the exporters of the collector are able to send data
+	// to their backends.
+	ConditionTypeExportersHealthy = "ExportersHealthy"
+
+	// metricSendFailedPrefix is the prefix of the collector metrics, which
+	// count the items the exporters failed to send.
+	metricSendFailedPrefix = "otelcol_exporter_send_failed_"
+	// metricSentPrefix is the prefix of the collector metrics, which count
+	// the items the exporters successfully sent.
+	metricSentPrefix = "otelcol_exporter_sent_"
+	// metricQueueSize is the collector metric for the current size of the
+	// sending queue of an exporter.
+	metricQueueSize = "otelcol_exporter_queue_size"
+	// metricQueueCapacity is the collector metric for the capacity of the
+	// sending queue of an exporter.
+	metricQueueCapacity = "otelcol_exporter_queue_capacity"
+	// labelExporter is the label of the collector metrics, which contains
+	// the name of the exporter.
+	labelExporter = "exporter"
+
+	// DefaultQueueUtilizationThreshold is the default ratio of the queue
+	// size to the queue capacity, above which an exporter is considered to
+	// be unhealthy.
+	DefaultQueueUtilizationThreshold = 0.9
+	// DefaultExportersProgressingThreshold is the default duration, after
+	// which unhealthy exporters render the condition false.
+	DefaultExportersProgressingThreshold = 5 * time.Minute
+)
+
+// exporterStats contains the statistics of an exporter, as read from the
+// internal metrics of the collector.
+type exporterStats struct {
+	sent          float64
+	failed        float64
+	queueSize     float64
+	queueCapacity float64
+}
+
+// ExportersHealthChecker is a [healthcheck.HealthCheck], which checks the
+// health of the exporters based on the internal metrics of the collector.
+//
+// The metrics are read from every ready collector pod behind the monitoring
+// Service, as the counters of the exporters are maintained per pod. An
+// exporter is considered to be unhealthy, if it failed to send data in a pod
+// since the previous check without sending any data successfully, or if its
+// sending queue is filled above the configured threshold. Unhealthy exporters
+// are reported as progressing, so that short outages of a backend do not
+// render the condition false right away.
+type ExportersHealthChecker struct {
+	logger               logr.Logger
+	seedClient           client.Client
+	httpClient           *http.Client
+	threshold            float64
+	progressingThreshold time.Duration
+
+	// mu protects the stats of the previous check.
+	mu sync.Mutex
+	// previous contains the stats of the exporters per namespace and pod as
+	// seen during the previous check.
+	previous map[string]map[string]map[string]exporterStats
+}
+
+var (
+	_ healthcheck.HealthCheck  = &ExportersHealthChecker{}
+	_ healthcheck.SourceClient = &ExportersHealthChecker{}
+)
+
+// NewExportersHealthChecker returns a new [ExportersHealthChecker], which
+// uses the given [http.Client] to read the metrics from the collector pods.
+func NewExportersHealthChecker(httpClient *http.Client) *ExportersHealthChecker {
+	return &ExportersHealthChecker{
+		logger:               log.Log.WithName("exporters-healthcheck"),
+		httpClient:           httpClient,
+		threshold:            DefaultQueueUtilizationThreshold,
+		progressingThreshold: DefaultExportersProgressingThreshold,
+		previous:             make(map[string]map[string]map[string]exporterStats),
+	}
+}
+
+// InjectSourceClient implements the [healthcheck.SourceClient] interface.
+func (h *ExportersHealthChecker) InjectSourceClient(seedClient client.Client) {
+	h.seedClient = seedClient
+}
+
+// SetLoggerSuffix implements the [healthcheck.HealthCheck] interface.
+func (h *ExportersHealthChecker) SetLoggerSuffix(provider, extension string) {
+	h.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-exporters", provider, extension))
+}
+
+// Check implements the [healthcheck.HealthCheck] interface.
+func (h *ExportersHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	endpoints, err := h.getCollectorEndpoints(ctx, request.Namespace)
+	if err != nil {
+		h.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+
+	if len(endpoints) == 0 {
+		// The stats of the pods are dropped, so that no stats are kept for
+		// collectors, which have been removed.
+		h.mu.Lock()
+		delete(h.previous, request.Namespace)
+		h.mu.Unlock()
+
+		detail := "no ready collector pods found"
+		h.logger.Info("Health check failed", "detail", detail)
+
+		return &healthcheck.SingleCheckResult{
+			Status:               gardencorev1beta1.ConditionProgressing,
+			Detail:               detail,
+			ProgressingThreshold: &h.progressingThreshold,
+		}, nil
+	}
+
+	current := make(map[string]map[string]exporterStats, len(endpoints))
+	for pod, url := range endpoints {
+		stats, err := h.getExporterStats(ctx, url)
+		if err != nil {
+			err = fmt.Errorf("pod %s: %w", pod, err)
+			h.logger.Error(err, "Health check failed")
+			return nil, err
+		}
+		current[pod] = stats
+	}
+
+	h.mu.Lock()
+	previous := h.previous[request.Namespace]
+	h.previous[request.Namespace] = current
+	h.mu.Unlock()
+
+	problems := make([]string, 0)
+	for _, pod := range slices.Sorted(maps.Keys(current)) {
+		for _, name := range slices.Sorted(maps.Keys(current[pod])) {
+			stats := current[pod][name]
+			if prev, ok := previous[pod][name]; ok && stats.failed > prev.failed && stats.sent <= prev.sent {
+				problems = append(problems, fmt.Sprintf("exporter %s fails to send data in pod %s", name, pod))
+			}
+
+			if stats.queueCapacity > 0 && stats.queueSize >= h.threshold*stats.queueCapacity {
+				problems = append(problems, fmt.Sprintf("sending queue of exporter %s is at %.0f%% of its capacity in pod %s", name, 100*stats.queueSize/stats.queueCapacity, pod))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		detail := strings.Join(problems, ", ")
+		h.logger.Info("Health check failed", "detail", detail)
+
+		return &healthcheck.SingleCheckResult{
+			Status:               gardencorev1beta1.ConditionProgressing,
+			Detail:               detail,
+			ProgressingThreshold: &h.progressingThreshold,
+		}, nil
+	}
+
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionTrue,
+	}, nil
+}
+
+// getCollectorEndpoints returns the URLs of the metrics endpoints of the
+// ready collector pods in the given namespace, keyed by the name of the pod.
+// The endpoints are read from the EndpointSlices of the monitoring Service of
+// the collector.
+func (h *ExportersHealthChecker) getCollectorEndpoints(ctx context.Context, namespace string) (map[string]string, error) {
+	if h.seedClient == nil {
+		return nil, errors.New("no seed client injected")
+	}
+
+	sliceList := &discoveryv1.EndpointSliceList{}
+	if err := h.seedClient.List(
+		ctx,
+		sliceList,
+		client.InNamespace(namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: actuator.CollectorMonitoringServiceName},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list endpoints of the collector: %w", err)
+	}
+
+	endpoints := make(map[string]string)
+	for _, slice := range sliceList.Items {
+		port := int32(actuator.CollectorMetricsPort)
+		for _, p := range slice.Ports {
+			if p.Port != nil {
+				port = *p.Port
+				break
+			}
+		}
+
+		for _, endpoint := range slice.Endpoints {
+			if len(endpoint.Addresses) == 0 || !ptr.Deref(endpoint.Conditions.Ready, true) {
+				continue
+			}
+
+			address := endpoint.Addresses[0]
+			pod := address
+			if endpoint.TargetRef != nil && endpoint.TargetRef.Name != "" {
+				pod = endpoint.TargetRef.Name
+			}
+
+			endpoints[pod] = metricsURL(address, port)
+		}
+	}
+
+	return endpoints, nil
+}
+
+// metricsURL returns the URL of the internal metrics endpoint of the collector
+// pod with the given address.
+func metricsURL(address string, port int32) string {
+	return fmt.Sprintf("http://%s/metrics", net.JoinHostPort(address, strconv.Itoa(int(port))))
+}
+
+// getExporterStats reads the internal metrics of a collector pod from the
+// given URL and returns the stats per exporter.
+func (h *ExportersHealthChecker) getExporterStats(ctx context.Context, url string) (map[string]exporterStats, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for collector metrics: %w", err)
+	}
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collector metrics: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get collector metrics: unexpected status code %d", resp.StatusCode)
+	}
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse collector metrics: %w", err)
+	}
+
+	stats := make(map[string]exporterStats)
+	for name, family := range families {
+		for _, metric := range family.GetMetric() {
+			exporter := getLabelValue(metric, labelExporter)
+			if exporter == "" {
+				continue
+			}
+
+			item := stats[exporter]
+			value := getValue(metric)
+			switch {
+			case strings.HasPrefix(name, metricSendFailedPrefix):
+				item.failed += value
+			case strings.HasPrefix(name, metricSentPrefix):
+				item.sent += value
+			case name == metricQueueSize:
+				item.queueSize += value
+			case name == metricQueueCapacity:
+				item.queueCapacity += value
+			default:
+				continue
+			}
+			stats[exporter] = item
+		}
+	}
+
+	return stats, nil
+}
+
+// getLabelValue returns the value of the label with the given name from the
+// metric, or an empty string if the metric does not have the label.
+func getLabelValue(metric *dto.Metric, name string) string {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+
+	return ""
+}
+
+// getValue returns the value of the given counter or gauge metric.
+func getValue(metric *dto.Metric) float64 {
+	switch {
+	case metric.GetCounter() != nil:
+		return metric.GetCounter().GetValue()
+	case metric.GetGauge() != nil:
+		return metric.GetGauge().GetValue()
+	case metric.GetUntyped() != nil:
+		return metric.GetUntyped().GetValue()
+	}
+
+	return 0
+}
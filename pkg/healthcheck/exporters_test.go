@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+	"github.com/gardener/gardener-extension-otelcol/pkg/healthcheck"
+)
+
+var _ = Describe("Exporters Health Check", func() {
+	const namespace = "shoot--local--local"
+
+	var (
+		ctx        = context.TODO()
+		request    = types.NamespacedName{Namespace: namespace, Name: "otelcol"}
+		metrics    map[string]string
+		status     int
+		httpClient *http.Client
+		checker    *healthcheck.ExportersHealthChecker
+		seedClient client.Client
+	)
+
+	// getMetrics returns the collector metrics for an exporter, which sent
+	// and failed to send the given number of spans and has the given queue
+	// size.
+	getMetrics := func(sent, failed, queueSize int) string {
+		return fmt.Sprintf(`# TYPE otelcol_exporter_sent_spans_total counter
+otelcol_exporter_sent_spans_total{exporter="otlp_http"} %d
+# TYPE otelcol_exporter_send_failed_spans_total counter
+otelcol_exporter_send_failed_spans_total{exporter="otlp_http"} %d
+# TYPE otelcol_exporter_queue_size gauge
+otelcol_exporter_queue_size{data_type="traces",exporter="otlp_http"} %d
+# TYPE otelcol_exporter_queue_capacity gauge
+otelcol_exporter_queue_capacity{data_type="traces",exporter="otlp_http"} 1000
+# TYPE otelcol_process_uptime_seconds_total counter
+otelcol_process_uptime_seconds_total 42
+`, sent, failed, queueSize)
+	}
+
+	// newCollectorPod starts a server, which serves the metrics of the
+	// collector pod with the given name, and returns an EndpointSlice of the
+	// monitoring Service with a ready endpoint for it.
+	newCollectorPod := func(pod string) *discoveryv1.EndpointSlice {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			_, _ = fmt.Fprint(w, metrics[pod])
+		}))
+		DeferCleanup(server.Close)
+		httpClient = server.Client()
+
+		host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		portNumber, err := strconv.ParseInt(port, 10, 32)
+		Expect(err).NotTo(HaveOccurred())
+
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod,
+				Namespace: namespace,
+				Labels:    map[string]string{discoveryv1.LabelServiceName: actuator.CollectorMonitoringServiceName},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Ports:       []discoveryv1.EndpointPort{{Port: new(int32(portNumber))}},
+			Endpoints: []discoveryv1.Endpoint{{
+				Addresses:  []string{host},
+				Conditions: discoveryv1.EndpointConditions{Ready: new(true)},
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: pod},
+			}},
+		}
+	}
+
+	// newChecker returns a new checker for the given collector pods.
+	newChecker := func(pods ...string) *healthcheck.ExportersHealthChecker {
+		builder := fake.NewClientBuilder()
+		for _, pod := range pods {
+			builder = builder.WithObjects(newCollectorPod(pod))
+		}
+
+		seedClient = builder.Build()
+		c := healthcheck.NewExportersHealthChecker(httpClient)
+		c.InjectSourceClient(seedClient)
+		c.SetLoggerSuffix("otelcol", "Extension")
+
+		return c
+	}
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		metrics = map[string]string{
+			"collector-0": getMetrics(0, 0, 0),
+			"collector-1": getMetrics(0, 0, 0),
+		}
+		checker = newChecker("collector-0")
+	})
+
+	It("should report healthy exporters", func() {
+		metrics["collector-0"] = getMetrics(10, 0, 0)
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		metrics["collector-0"] = getMetrics(20, 0, 5)
+		result, err = checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should not report failures, which happened before the first check", func() {
+		metrics["collector-0"] = getMetrics(0, 10, 0)
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should report an exporter, which continuously fails to send data", func() {
+		metrics["collector-0"] = getMetrics(10, 5, 0)
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		metrics["collector-0"] = getMetrics(10, 15, 0)
+		result, err = checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+		Expect(result.Detail).To(Equal("exporter otlp_http fails to send data in pod collector-0"))
+		Expect(result.ProgressingThreshold).To(Equal(new(healthcheck.DefaultExportersProgressingThreshold)))
+
+		// Partial failures are not reported, as long as the exporter
+		// still sends data.
+		metrics["collector-0"] = getMetrics(20, 20, 0)
+		result, err = checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should report an exporter with a filled sending queue", func() {
+		metrics["collector-0"] = getMetrics(10, 0, 950)
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+		Expect(result.Detail).To(Equal("sending queue of exporter otlp_http is at 95% of its capacity in pod collector-0"))
+	})
+
+	It("should report an exporter, which fails to send data in one of the pods", func() {
+		checker = newChecker("collector-0", "collector-1")
+
+		metrics["collector-0"] = getMetrics(10, 5, 0)
+		metrics["collector-1"] = getMetrics(10, 0, 0)
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		// The data sent by the other pod does not hide the failures.
+		metrics["collector-0"] = getMetrics(10, 15, 0)
+		metrics["collector-1"] = getMetrics(20, 0, 0)
+		result, err = checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+		Expect(result.Detail).To(Equal("exporter otlp_http fails to send data in pod collector-0"))
+	})
+
+	It("should report no ready collector pods", func() {
+		checker = newChecker()
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+		Expect(result.Detail).To(Equal("no ready collector pods found"))
+	})
+
+	It("should drop the stats of the previous check, when no collector pods are found", func() {
+		metrics["collector-0"] = getMetrics(10, 5, 0)
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		slice := &discoveryv1.EndpointSlice{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "collector-0"}, slice)).To(Succeed())
+		Expect(seedClient.Delete(ctx, slice)).To(Succeed())
+		result, err = checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+
+		// The failures are compared to the stats of the previous check, which
+		// have been dropped, and are therefore not reported.
+		slice.ResourceVersion = ""
+		Expect(seedClient.Create(ctx, slice)).To(Succeed())
+		metrics["collector-0"] = getMetrics(10, 15, 0)
+		result, err = checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should fail when the metrics cannot be read", func() {
+		status = http.StatusServiceUnavailable
+		_, err := checker.Check(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 503")))
+	})
+})
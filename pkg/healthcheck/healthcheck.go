@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package healthcheck provides utilities for registering health check
+// controllers, which report the health of the resources deployed by the
+// extension in the status of the [extensionsv1alpha1.Extension] resources.
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crctrl "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+)
+
+// ErrInvalidHealthCheck is an error, which is returned when attempting to
+// create a [HealthCheck], but the configuration was found to be invalid.
+var ErrInvalidHealthCheck = errors.New("invalid health check config")
+
+// HealthCheck is a wrapper for the health check controller of the Gardener
+// extensions library, which periodically checks the health of the resources
+// deployed by the extension and writes the results as conditions to the
+// status of the [extensionsv1alpha1.Extension] resources.
+type HealthCheck struct {
+	extensionType     string
+	extensionClasses  []extensionsv1alpha1.ExtensionClass
+	syncPeriod        time.Duration
+	controllerOptions crctrl.Options
+}
+
+// Option is a function, which configures the [HealthCheck].
+type Option func(h *HealthCheck) error
+
+// New creates a new [HealthCheck] with the given options.
+func New(opts ...Option) (*HealthCheck, error) {
+	h := &HealthCheck{
+		extensionClasses: make([]extensionsv1alpha1.ExtensionClass, 0),
+		syncPeriod:       30 * time.Second,
+		controllerOptions: crctrl.Options{
+			MaxConcurrentReconciles: 5,
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		},
+	}
+
+	for _, opt := range opts {
+		if err := opt(h); err != nil {
+			return nil, err
+		}
+	}
+
+	if h.extensionType == "" {
+		return nil, fmt.Errorf("%w: missing extension type", ErrInvalidHealthCheck)
+	}
+	if len(h.extensionClasses) == 0 {
+		return nil, fmt.Errorf("%w: missing extension class", ErrInvalidHealthCheck)
+	}
+	if h.syncPeriod <= 0 {
+		return nil, fmt.Errorf("%w: invalid sync period", ErrInvalidHealthCheck)
+	}
+
+	return h, nil
+}
+
+// SetupWithManager registers the [HealthCheck] controller with the given
+// [manager.Manager].
+func (h *HealthCheck) SetupWithManager(_ context.Context, mgr manager.Manager) error {
+	return healthcheck.DefaultRegistration(
+		h.extensionType,
+		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.ExtensionResource),
+		func() client.ObjectList { return &extensionsv1alpha1.ExtensionList{} },
+		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Extension{} },
+		mgr,
+		healthcheck.DefaultAddArgs{
+			Controller: h.controllerOptions,
+			HealthCheckConfig: extensionsconfigv1alpha1.HealthCheckConfig{
+				SyncPeriod: metav1.Duration{Duration: h.syncPeriod},
+			},
+			ExtensionClasses: h.extensionClasses,
+		},
+		nil,
+		HealthChecks(),
+		sets.New[gardencorev1beta1.ConditionType](),
+	)
+}
+
+// HealthChecks returns the health checks for the resources deployed by the
+// extension, grouped by the condition types they contribute to.
+//
+// The ControlPlaneHealthy condition reflects the health of the managed
+// resource deploying the collector into the seed cluster, the StatefulSet of
+// the collector and the Deployment of the Target Allocator. The
+// SystemComponentsHealthy condition reflects the health of the managed
+// resource deploying the RBAC resources into the shoot cluster. The
+// ExportersHealthy condition reflects whether the exporters of the collector
+// are able to send data to their backends.
+func HealthChecks() []healthcheck.ConditionTypeToHealthCheck {
+	return []healthcheck.ConditionTypeToHealthCheck{
+		{
+			ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
+			HealthCheck:   general.CheckManagedResource(actuator.ManagedResourceName),
+		},
+		{
+			ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
+			HealthCheck:   general.NewSeedStatefulSetChecker(actuator.CollectorStatefulSetName),
+		},
+		{
+			ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
+			HealthCheck:   general.NewSeedDeploymentHealthChecker(actuator.TargetAllocatorDeploymentName),
+		},
+		{
+			ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+			HealthCheck:   general.CheckManagedResource(actuator.ShootManagedResourceName),
+		},
+		{
+			ConditionType: ConditionTypeExportersHealthy,
+			HealthCheck:   NewExportersHealthChecker(&http.Client{Timeout: 10 * time.Second}),
+		},
+	}
+}
+
+// WithExtensionType is an [Option], which configures the [HealthCheck] to
+// check the health of extension resources of the given type.
+func WithExtensionType(extensionType string) Option {
+	opt := func(h *HealthCheck) error {
+		h.extensionType = extensionType
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithExtensionClass is an [Option], which configures the [HealthCheck] to be
+// responsible for the given [extensionsv1alpha1.ExtensionClass].
+func WithExtensionClass(item extensionsv1alpha1.ExtensionClass) Option {
+	opt := func(h *HealthCheck) error {
+		h.extensionClasses = append(h.extensionClasses, item)
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithSyncPeriod is an [Option], which configures the [HealthCheck] to check
+// the health of the extension resources on the given interval.
+func WithSyncPeriod(period time.Duration) Option {
+	opt := func(h *HealthCheck) error {
+		h.syncPeriod = period
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithMaxConcurrentReconciles is an [Option], which configures the
+// [HealthCheck] with the given max concurrent reconciles.
+func WithMaxConcurrentReconciles(val int) Option {
+	opt := func(h *HealthCheck) error {
+		h.controllerOptions.MaxConcurrentReconciles = val
+
+		return nil
+	}
+
+	return opt
+}
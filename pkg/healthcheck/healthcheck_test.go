@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/healthcheck"
+)
+
+var _ = Describe("Health Check Controller", Ordered, func() {
+	It("should fail to create health check controller with missing extension type", func() {
+		h, err := healthcheck.New()
+
+		Expect(err).To(MatchError(healthcheck.ErrInvalidHealthCheck))
+		Expect(err).To(MatchError(ContainSubstring("missing extension type")))
+		Expect(h).To(BeNil())
+	})
+
+	It("should fail to create health check controller with missing extension class", func() {
+		h, err := healthcheck.New(
+			healthcheck.WithExtensionType("otelcol"),
+		)
+
+		Expect(err).To(MatchError(healthcheck.ErrInvalidHealthCheck))
+		Expect(err).To(MatchError(ContainSubstring("missing extension class")))
+		Expect(h).To(BeNil())
+	})
+
+	It("should fail to create health check controller with invalid sync period", func() {
+		h, err := healthcheck.New(
+			healthcheck.WithExtensionType("otelcol"),
+			healthcheck.WithExtensionClass(extensionsv1alpha1.ExtensionClassShoot),
+			healthcheck.WithSyncPeriod(0),
+		)
+
+		Expect(err).To(MatchError(healthcheck.ErrInvalidHealthCheck))
+		Expect(err).To(MatchError(ContainSubstring("invalid sync period")))
+		Expect(h).To(BeNil())
+	})
+
+	It("should contribute to the control plane, system components and exporters conditions", func() {
+		conditionTypes := make([]string, 0)
+		for _, check := range healthcheck.HealthChecks() {
+			Expect(check.HealthCheck).NotTo(BeNil())
+			conditionTypes = append(conditionTypes, check.ConditionType)
+		}
+
+		Expect(conditionTypes).To(ConsistOf(
+			string(gardencorev1beta1.ShootControlPlaneHealthy),
+			string(gardencorev1beta1.ShootControlPlaneHealthy),
+			string(gardencorev1beta1.ShootControlPlaneHealthy),
+			string(gardencorev1beta1.ShootSystemComponentsHealthy),
+			healthcheck.ConditionTypeExportersHealthy,
+		))
+	})
+
+	It("should successfully create health check controller and register it", func() {
+		h, err := healthcheck.New(
+			healthcheck.WithExtensionType("otelcol"),
+			healthcheck.WithExtensionClass(extensionsv1alpha1.ExtensionClassShoot),
+			healthcheck.WithSyncPeriod(time.Minute),
+			healthcheck.WithMaxConcurrentReconciles(1),
+		)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h).NotTo(BeNil())
+
+		m, err := manager.New(&rest.Config{}, manager.Options{Scheme: kubernetes.SeedScheme})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.SetupWithManager(context.TODO(), m)).To(Succeed())
+	})
+})